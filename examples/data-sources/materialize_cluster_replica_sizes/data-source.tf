@@ -0,0 +1,12 @@
+data "materialize_cluster_replica_sizes" "all" {}
+
+locals {
+  credits_per_hour = {
+    for s in data.materialize_cluster_replica_sizes.all.sizes : s.size => s.credits_per_hour
+  }
+}
+
+# The hourly cost in credits of a managed cluster
+output "cluster_credits_per_hour" {
+  value = local.credits_per_hour["100cc"] * 2
+}
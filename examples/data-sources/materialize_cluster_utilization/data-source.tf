@@ -0,0 +1,15 @@
+data "materialize_cluster_utilization" "production" {
+  cluster_name = "production"
+}
+
+check "production_cluster_healthy" {
+  assert {
+    condition     = alltrue([for r in data.materialize_cluster_utilization.production.replicas : r.status == "ready"])
+    error_message = "A replica of the production cluster is not ready, it may be crash-looping."
+  }
+
+  assert {
+    condition     = alltrue([for r in data.materialize_cluster_utilization.production.replicas : r.memory_percent < 90])
+    error_message = "The production cluster is close to running out of memory, consider a larger size."
+  }
+}
@@ -0,0 +1,21 @@
+data "materialize_objects" "all" {}
+
+data "materialize_objects" "materialized_views" {
+  types         = ["materialized-view"]
+  database_name = "materialize"
+}
+
+# Objects named like orders maintained by a cluster
+data "materialize_objects" "orders" {
+  types        = ["materialized-view", "index"]
+  name_regex   = "^orders"
+  cluster_name = "quickstart"
+}
+
+# Policy check: every source must have a comment
+locals {
+  undocumented_sources = [
+    for o in data.materialize_objects.all.objects : o.qualified_sql_name
+    if o.type == "source" && o.comment == ""
+  ]
+}
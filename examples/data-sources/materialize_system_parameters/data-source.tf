@@ -0,0 +1,5 @@
+data "materialize_system_parameters" "all" {}
+
+output "max_clusters" {
+  value = one([for p in data.materialize_system_parameters.all.parameters : p.value if p.name == "max_clusters"])
+}
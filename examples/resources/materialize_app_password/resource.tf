@@ -1,3 +1,13 @@
 resource "materialize_app_password" "example_app_password" {
   name = "example_app_password_name"
 }
+
+# Service app passwords authenticate as a service user with the given roles
+# and are replaced once they are older than rotate_after
+resource "materialize_app_password" "example_service_password" {
+  name         = "dbt_ci"
+  type         = "service"
+  user         = "dbt_ci"
+  roles        = ["Member"]
+  rotate_after = "2160h"
+}
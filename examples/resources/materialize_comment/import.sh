@@ -0,0 +1,6 @@
+# Comments can be imported using the object type, the qualified name of the object and the optional column:
+terraform import materialize_comment.view "<region>:VIEW|<database>.<schema>.<view>"
+terraform import materialize_comment.source_column "<region>:SOURCE|<database>.<schema>.<source>|<column>"
+
+# Schemas are qualified with their database and cluster replicas with their cluster
+# The region is the region where the database is located (e.g. aws/us-east-1)
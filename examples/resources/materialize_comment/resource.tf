@@ -0,0 +1,26 @@
+# Comment on a view
+resource "materialize_comment" "view" {
+  object_type   = "VIEW"
+  object_name   = materialize_view.simple_view.name
+  schema_name   = materialize_view.simple_view.schema_name
+  database_name = materialize_view.simple_view.database_name
+  comment       = "Orders enriched with customer details"
+}
+
+# Comment on a column of a source
+resource "materialize_comment" "source_column" {
+  object_type   = "SOURCE"
+  object_name   = "auction_house"
+  schema_name   = "public"
+  database_name = "materialize"
+  column_name   = "id"
+  comment       = "Unique id of the auction"
+}
+
+# Comment on a cluster replica
+resource "materialize_comment" "replica" {
+  object_type  = "CLUSTER REPLICA"
+  object_name  = "r1"
+  cluster_name = "quickstart"
+  comment      = "Replica serving dashboards"
+}
@@ -0,0 +1,17 @@
+# Create an AWS connection using IAM role assumption
+resource "materialize_connection_aws" "example_aws_connection" {
+  name            = "example_aws_connection"
+  schema_name     = "public"
+  aws_region      = "us-east-1"
+  assume_role_arn = "arn:aws:iam::123456789012:role/MaterializeS3Exporter"
+}
+
+# CREATE CONNECTION example_aws_connection TO AWS (
+#     REGION = 'us-east-1',
+#     ASSUME ROLE ARN = 'arn:aws:iam::123456789012:role/MaterializeS3Exporter'
+# );
+
+# Use the computed outputs to configure the IAM role trust policy
+output "aws_connection_external_id" {
+  value = materialize_connection_aws.example_aws_connection.external_id
+}
@@ -54,3 +54,22 @@ resource "materialize_connection_kafka" "example_kafka_connection_multiple_broke
 #        'b-2.hostname-2:9096' USING AWS PRIVATELINK "materialize"."public"."example_aws_privatelink_conn" (PORT 9002, AVAILABILITY ZONE 'use1-az2')
 #     )
 # );
+
+resource "materialize_connection_kafka" "example_kafka_connection_msk_iam" {
+  name = "example_kafka_connection_msk_iam"
+  kafka_broker {
+    broker = "b-1.hostname-1:9098"
+  }
+  security_protocol = "SASL_SSL"
+  aws_connection {
+    name          = "example_aws_connection"
+    database_name = "materialize"
+    schema_name   = "public"
+  }
+}
+
+# CREATE CONNECTION materialize.public.example_kafka_connection_msk_iam TO KAFKA (
+#     BROKERS ('b-1.hostname-1:9098'),
+#     SECURITY PROTOCOL = 'SASL_SSL',
+#     AWS CONNECTION = "materialize"."public"."example_aws_connection"
+# );
@@ -0,0 +1,2 @@
+# Regions can be imported using the region id:
+terraform import materialize_region.example_region aws/us-west-2
@@ -0,0 +1,3 @@
+resource "materialize_region" "example_region" {
+  region = "aws/us-west-2"
+}
@@ -1,3 +1,9 @@
 resource "materialize_role" "example_role" {
   name = "example_role"
 }
+
+# Create the same role in several regions
+resource "materialize_role" "example_global_role" {
+  name    = "example_global_role"
+  regions = ["aws/us-east-1", "aws/eu-west-1"]
+}
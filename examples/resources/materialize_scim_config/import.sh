@@ -0,0 +1,2 @@
+# SCIM configurations can be imported using the SCIM configuration id:
+terraform import materialize_scim_config.example_scim_config <scim_config_id>
@@ -0,0 +1,10 @@
+resource "materialize_scim_config" "example_scim_config" {
+  source          = "okta"
+  connection_name = "okta-scim"
+}
+
+# The token is only available in the state of the resource that created it
+output "scim_token" {
+  value     = materialize_scim_config.example_scim_config.token
+  sensitive = true
+}
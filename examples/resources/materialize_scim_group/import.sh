@@ -0,0 +1,2 @@
+# Groups can be imported using the group id:
+terraform import materialize_scim_group.example_scim_group <group_id>
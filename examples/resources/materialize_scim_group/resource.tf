@@ -0,0 +1,5 @@
+resource "materialize_scim_group" "example_scim_group" {
+  name        = "data-engineers"
+  description = "Data engineering team"
+  roles       = ["Member", "Billing Manager"]
+}
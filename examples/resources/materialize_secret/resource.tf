@@ -2,3 +2,10 @@ resource "materialize_secret" "example_secret" {
   name  = "secret"
   value = "some-secret-value"
 }
+
+# Create the same secret in several regions
+resource "materialize_secret" "example_global_secret" {
+  name    = "global_secret"
+  value   = "some-secret-value"
+  regions = ["aws/us-east-1", "aws/eu-west-1"]
+}
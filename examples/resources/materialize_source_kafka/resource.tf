@@ -26,3 +26,38 @@ resource "materialize_source_kafka" "example_source_kafka" {
 #   FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
 #   ENVELOPE NONE
 #   WITH (SIZE = '3xsmall');
+
+resource "materialize_source_kafka" "example_source_kafka_upsert" {
+  name        = "source_kafka_upsert"
+  schema_name = "schema"
+  size        = "3xsmall"
+  topic       = "data"
+  kafka_connection {
+    name          = "kafka_connection"
+    database_name = "database"
+    schema_name   = "schema"
+  }
+  key_format {
+    text = true
+  }
+  value_format {
+    json = true
+  }
+  envelope {
+    upsert = true
+    upsert_options {
+      value_decoding_errors {
+        inline {
+          enabled = true
+        }
+      }
+    }
+  }
+}
+
+# CREATE SOURCE source_kafka_upsert
+#   FROM KAFKA CONNECTION "database"."schema"."kafka_connection" (TOPIC 'data')
+#   KEY FORMAT TEXT
+#   VALUE FORMAT JSON
+#   ENVELOPE UPSERT (VALUE DECODING ERRORS = INLINE)
+#   WITH (SIZE = '3xsmall');
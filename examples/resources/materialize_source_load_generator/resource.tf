@@ -15,3 +15,49 @@ resource "materialize_source_load_generator" "example_source_load_generator" {
 #   FROM LOAD GENERATOR COUNTER
 #   (TICK INTERVAL '500ms' SCALE FACTOR 0.01)
 #   WITH (SIZE = '3xsmall');
+
+resource "materialize_source_load_generator" "example_source_load_generator_tpch" {
+  name                = "source_load_generator_tpch"
+  schema_name         = "schema"
+  cluster_name        = "quickstart"
+  load_generator_type = "TPCH"
+
+  tpch_options {
+    scale_factor = 0.01
+  }
+
+  table {
+    name  = "orders"
+    alias = "tpch_orders"
+  }
+  table {
+    name = "lineitem"
+  }
+}
+
+# CREATE SOURCE schema.source_load_generator_tpch
+#   IN CLUSTER quickstart
+#   FROM LOAD GENERATOR TPCH
+#   (SCALE FACTOR 0.01)
+#   FOR TABLES (orders AS tpch_orders, lineitem AS lineitem);
+
+resource "materialize_source_load_generator" "example_source_load_generator_key_value" {
+  name                = "source_load_generator_key_value"
+  schema_name         = "schema"
+  cluster_name        = "quickstart"
+  load_generator_type = "KEY VALUE"
+
+  key_value_options {
+    keys            = 1024
+    snapshot_rounds = 2
+    value_size      = 256
+    seed            = 42
+    partitions      = 4
+    batch_size      = 32
+  }
+}
+
+# CREATE SOURCE schema.source_load_generator_key_value
+#   IN CLUSTER quickstart
+#   FROM LOAD GENERATOR KEY VALUE
+#   (KEYS 1024, SNAPSHOT ROUNDS 2, VALUE SIZE 256, SEED 42, PARTITIONS 4, BATCH SIZE 32);
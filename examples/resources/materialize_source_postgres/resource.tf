@@ -4,6 +4,9 @@ resource "materialize_source_postgres" "example_source_postgres" {
   size        = "3xsmall"
   publication = "mz_source"
 
+  # Recreate subsources stalled by incompatible upstream schema changes
+  refresh_on_schema_change = true
+
   postgres_connection {
     name = "pg_connection"
     # Optional parameters
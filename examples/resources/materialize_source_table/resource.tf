@@ -0,0 +1,42 @@
+resource "materialize_source_table" "postgres_table" {
+  name          = "table1"
+  schema_name   = materialize_schema.schema.name
+  database_name = materialize_database.database.name
+
+  source {
+    name          = materialize_source_postgres.example_source_postgres.name
+    schema_name   = materialize_source_postgres.example_source_postgres.schema_name
+    database_name = materialize_source_postgres.example_source_postgres.database_name
+  }
+
+  upstream_name        = "table1"
+  upstream_schema_name = "public"
+  text_columns         = ["status"]
+}
+
+# CREATE TABLE database.schema.table1
+#   FROM SOURCE materialize.public.example_source_postgres (REFERENCE public.table1)
+#   WITH (TEXT COLUMNS (status));
+
+resource "materialize_source_table" "kafka_table" {
+  name = "orders"
+
+  source {
+    name = materialize_source_kafka.example_source_kafka.name
+  }
+
+  upstream_name = "orders"
+
+  format {
+    json = true
+  }
+
+  envelope {
+    none = true
+  }
+}
+
+# CREATE TABLE materialize.public.orders
+#   FROM SOURCE materialize.public.example_source_kafka (REFERENCE orders)
+#   FORMAT JSON
+#   ENVELOPE NONE;
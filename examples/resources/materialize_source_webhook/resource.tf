@@ -31,4 +31,25 @@ resource "materialize_source_webhook" "example_webhook" {
 #   CHECK (
 #     WITH ( HEADERS, SECRET materialize.public.password AS secret)
 #     headers->'x-mz-api-key' = secret
-#   );
\ No newline at end of file
+#   );
+
+# Validate the signature of the requests sent by GitHub
+resource "materialize_source_webhook" "github_webhook" {
+  name         = "github_webhook"
+  cluster_name = materialize_cluster.cluster.name
+  body_format  = "json"
+
+  validation_preset {
+    vendor = "GITHUB"
+    secret {
+      name          = materialize_secret.github_secret.name
+      database_name = materialize_secret.github_secret.database_name
+      schema_name   = materialize_secret.github_secret.schema_name
+    }
+  }
+}
+
+# The URL to configure in the service sending the requests
+output "webhook_url" {
+  value = materialize_source_webhook.example_webhook.url
+}
@@ -0,0 +1,6 @@
+resource "materialize_sql_statement" "mysql_connection" {
+  name        = "mysql_connection"
+  create_sql  = "CREATE CONNECTION mysql_connection TO MYSQL (HOST 'instance.foo000.us-west-1.rds.amazonaws.com', USER 'root', PASSWORD SECRET mysqlpass)"
+  destroy_sql = "DROP CONNECTION mysql_connection"
+  read_sql    = "SELECT id FROM mz_connections WHERE name = 'mysql_connection'"
+}
@@ -0,0 +1,2 @@
+# SSO configurations can be imported using the SSO configuration id:
+terraform import materialize_sso_config.example_sso_config <sso_config_id>
@@ -0,0 +1,14 @@
+resource "materialize_sso_config" "example_sso_config" {
+  type               = "saml"
+  sso_endpoint       = "https://idp.example.com/sso/saml"
+  public_certificate = file("${path.module}/idp-certificate.pem")
+  sign_request       = true
+}
+
+# OpenID Connect configurations authenticate with a client ID and secret
+resource "materialize_sso_config" "example_oidc_config" {
+  type           = "oidc"
+  sso_endpoint   = "https://idp.example.com"
+  oidc_client_id = "materialize"
+  oidc_secret    = var.oidc_secret
+}
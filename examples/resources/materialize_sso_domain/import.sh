@@ -0,0 +1,2 @@
+# SSO domains can be imported using the SSO configuration id and the domain id:
+terraform import materialize_sso_domain.example_sso_domain <sso_config_id>:<domain_id>
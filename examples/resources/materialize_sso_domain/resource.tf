@@ -0,0 +1,15 @@
+resource "materialize_sso_domain" "example_sso_domain" {
+  sso_config_id = materialize_sso_config.example_sso_config.id
+  domain        = "example.com"
+}
+
+# Validate the domain once the TXT record is published
+resource "materialize_sso_domain" "example_validated_domain" {
+  sso_config_id = materialize_sso_config.example_sso_config.id
+  domain        = "corp.example.com"
+  validate      = true
+}
+
+output "txt_record" {
+  value = materialize_sso_domain.example_sso_domain.txt_record
+}
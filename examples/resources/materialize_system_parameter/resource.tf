@@ -0,0 +1,9 @@
+resource "materialize_system_parameter" "max_clusters" {
+  name  = "max_clusters"
+  value = "100"
+}
+
+resource "materialize_system_parameter" "max_sources" {
+  name  = "max_sources"
+  value = "200"
+}
@@ -18,3 +18,14 @@ resource "materialize_type" "map_type" {
     value_type = "int4"
   }
 }
+
+resource "materialize_type" "nested_list_type" {
+  name          = "int4_list_list"
+  schema_name   = materialize_schema.schema.name
+  database_name = materialize_database.database.name
+  comment       = "List of int4 lists"
+
+  list_properties {
+    element_type = materialize_type.list_type.qualified_sql_name
+  }
+}
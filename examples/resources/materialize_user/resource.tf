@@ -2,3 +2,10 @@ resource "materialize_user" "example_user" {
   email = "example-user@example.com"
   roles = ["Member", "Admin"]
 }
+
+# Custom organization roles can be assigned by name
+resource "materialize_user" "example_billing_user" {
+  email    = "billing-user@example.com"
+  roles    = ["Member", "Billing Manager"]
+  metadata = jsonencode({ team = "finance" })
+}
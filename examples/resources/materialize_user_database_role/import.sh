@@ -0,0 +1,5 @@
+# User database roles can be imported using the role id:
+terraform import materialize_user_database_role.example_user_database_role <region>:<role_id>
+
+# Role id and information be found in the `mz_catalog.mz_roles` table
+# The region is the region where the database is located (e.g. aws/us-east-1)
@@ -0,0 +1,15 @@
+resource "materialize_user" "example_user" {
+  email = "example-user@example.com"
+  roles = ["Member"]
+}
+
+resource "materialize_role" "analyst" {
+  name = "analyst"
+}
+
+# Create the database role of the user before their first login
+resource "materialize_user_database_role" "example_user_database_role" {
+  email  = materialize_user.example_user.email
+  roles  = [materialize_role.analyst.name]
+  region = "aws/us-east-1"
+}
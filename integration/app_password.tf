@@ -2,3 +2,11 @@ resource "materialize_app_password" "example_password" {
   for_each = toset(["1", "2", "3", "4", "5"])
   name     = "example_password_${each.key}"
 }
+
+resource "materialize_app_password" "example_service_password" {
+  name         = "example_service_password"
+  type         = "service"
+  user         = "ci"
+  roles        = ["Member", "Billing Manager"]
+  rotate_after = "720h"
+}
@@ -0,0 +1,14 @@
+resource "materialize_comment" "view_column" {
+  object_type   = "VIEW"
+  object_name   = materialize_view.simple_view.name
+  schema_name   = materialize_view.simple_view.schema_name
+  database_name = materialize_view.simple_view.database_name
+  column_name   = "id"
+  comment       = "view column comment"
+}
+
+resource "materialize_comment" "cluster" {
+  object_type = "CLUSTER"
+  object_name = materialize_cluster.cluster_source.name
+  comment     = "cluster comment"
+}
@@ -213,6 +213,14 @@ resource "materialize_connection_kafka" "kafka_conn_ssh_broker" {
   validate = false
 }
 
+resource "materialize_connection_aws" "aws_connection" {
+  name    = "aws_connection"
+  comment = "connection aws comment"
+
+  aws_region      = "us-east-1"
+  assume_role_arn = "arn:aws:iam::123456789012:role/Materialize"
+}
+
 resource "materialize_connection_postgres" "postgres_connection" {
   name    = "postgres_connection"
   comment = "connection postgres comment"
@@ -1 +1,15 @@
 data "materialize_egress_ips" "all" {}
+
+data "materialize_cluster_replica_sizes" "all" {}
+
+data "materialize_cluster_utilization" "all" {}
+
+data "materialize_system_parameters" "all" {}
+
+data "materialize_objects" "all" {}
+
+data "materialize_objects" "views" {
+  types         = ["view", "materialized-view"]
+  database_name = materialize_database.database.name
+  name_regex    = "view$"
+}
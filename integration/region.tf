@@ -3,3 +3,11 @@ data "materialize_region" "all" {}
 output "region" {
   value = data.materialize_region.all
 }
+
+resource "materialize_region" "us_west" {
+  region = "aws/us-west-2"
+}
+
+output "region_us_west_sql_address" {
+  value = materialize_region.us_west.sql_address
+}
@@ -37,3 +37,8 @@ output "qualified_role" {
 }
 
 data "materialize_role" "all" {}
+
+resource "materialize_role" "regions" {
+  name    = "regions-role"
+  regions = ["aws/us-east-1"]
+}
@@ -38,6 +38,53 @@ resource "materialize_source_load_generator" "load_generator_auction" {
   }
 }
 
+resource "materialize_source_load_generator" "load_generator_auction_tables" {
+  name                = "load_gen_auction_tables"
+  schema_name         = materialize_schema.schema.name
+  database_name       = materialize_database.database.name
+  cluster_name        = materialize_cluster.cluster_source.name
+  load_generator_type = "AUCTION"
+
+  table {
+    name  = "bids"
+    alias = "auction_tables_bids"
+  }
+  table {
+    name  = "auctions"
+    alias = "auction_tables_auctions"
+  }
+}
+
+resource "materialize_source_load_generator" "load_generator_key_value" {
+  name                = "load_gen_key_value"
+  schema_name         = materialize_schema.schema.name
+  database_name       = materialize_database.database.name
+  cluster_name        = materialize_cluster.cluster_source.name
+  load_generator_type = "KEY VALUE"
+
+  key_value_options {
+    keys            = 16
+    snapshot_rounds = 3
+    value_size      = 10
+    seed            = 123
+    partitions      = 4
+    batch_size      = 2
+    tick_interval   = "1s"
+  }
+}
+
+resource "materialize_source_load_generator" "load_generator_clock" {
+  name                = "load_gen_clock"
+  schema_name         = materialize_schema.schema.name
+  database_name       = materialize_database.database.name
+  cluster_name        = materialize_cluster.cluster_source.name
+  load_generator_type = "CLOCK"
+
+  clock_options {
+    tick_interval = "1s"
+  }
+}
+
 resource "materialize_source_load_generator" "load_generator_marketing" {
   name                = "load_gen_marketing"
   schema_name         = materialize_schema.schema.name
@@ -68,6 +115,8 @@ resource "materialize_source_postgres" "example_source_postgres" {
   size         = "3xsmall"
   text_columns = ["table1.id"]
 
+  refresh_on_schema_change = true
+
   postgres_connection {
     name          = materialize_connection_postgres.postgres_connection.name
     schema_name   = materialize_connection_postgres.postgres_connection.schema_name
@@ -101,6 +150,18 @@ resource "materialize_source_postgres" "example_source_postgres_schema" {
 
 }
 
+resource "materialize_source_table" "example_source_table_postgres" {
+  name                 = "source_table3"
+  upstream_name        = "table3"
+  upstream_schema_name = "public"
+
+  source {
+    name          = materialize_source_postgres.example_source_postgres.name
+    schema_name   = materialize_source_postgres.example_source_postgres.schema_name
+    database_name = materialize_source_postgres.example_source_postgres.database_name
+  }
+}
+
 resource "materialize_source_kafka" "example_source_kafka_format_text" {
   name    = "source_kafka_text"
   comment = "source kafka comment"
@@ -162,6 +223,34 @@ resource "materialize_source_kafka" "example_source_kafka_format_avro" {
   depends_on = [materialize_sink_kafka.sink_kafka]
 }
 
+resource "materialize_source_kafka" "example_source_kafka_upsert_errors" {
+  name  = "source_kafka_upsert_errors"
+  size  = "3xsmall"
+  topic = "topic1"
+  kafka_connection {
+    name          = materialize_connection_kafka.kafka_connection.name
+    schema_name   = materialize_connection_kafka.kafka_connection.schema_name
+    database_name = materialize_connection_kafka.kafka_connection.database_name
+  }
+  key_format {
+    text = true
+  }
+  value_format {
+    json = true
+  }
+  envelope {
+    upsert = true
+    upsert_options {
+      value_decoding_errors {
+        inline {
+          enabled = true
+          alias   = "decoding_error"
+        }
+      }
+    }
+  }
+}
+
 resource "materialize_source_webhook" "example_webhook_source" {
   name             = "example_webhook_source"
   comment          = "source webhook comment"
@@ -189,6 +278,10 @@ resource "materialize_source_webhook" "example_webhook_source" {
   }
 }
 
+output "webhook_source_url" {
+  value = materialize_source_webhook.example_webhook_source.url
+}
+
 resource "materialize_source_grant" "source_grant_select" {
   role_name     = materialize_role.role_1.name
   privilege     = "SELECT"
@@ -0,0 +1,22 @@
+resource "materialize_sso_config" "saml" {
+  type               = "saml"
+  sso_endpoint       = "https://idp.materialize.com/sso/saml"
+  public_certificate = "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----"
+}
+
+resource "materialize_sso_domain" "materialize" {
+  sso_config_id = materialize_sso_config.saml.id
+  domain        = "materialize.com"
+  validate      = true
+}
+
+resource "materialize_scim_group" "data_engineers" {
+  name        = "data-engineers"
+  description = "Data engineering team"
+  roles       = ["Member", "Billing Manager"]
+}
+
+resource "materialize_scim_config" "okta" {
+  source          = "okta"
+  connection_name = "okta-scim"
+}
@@ -42,6 +42,7 @@ resource "materialize_type" "list_nested_type" {
   name          = "int4_nested_list"
   schema_name   = materialize_schema.schema.name
   database_name = materialize_database.database.name
+  comment       = "nested list type"
 
   list_properties {
     element_type = materialize_type.list_type.qualified_sql_name
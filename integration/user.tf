@@ -3,3 +3,14 @@ resource "materialize_user" "example_user" {
   email    = "example-user${each.key}@example.com"
   roles    = ["Member", "Admin"]
 }
+
+resource "materialize_user" "billing_user" {
+  email    = "billing-user@example.com"
+  roles    = ["Member", "Billing Manager"]
+  metadata = jsonencode({ team = "finance" })
+}
+
+resource "materialize_user_database_role" "billing_user" {
+  email = materialize_user.billing_user.email
+  roles = [materialize_role.role_1.name, materialize_role.role_2.name]
+}
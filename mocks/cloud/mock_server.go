@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sync"
+	"time"
 )
 
 type Region struct {
@@ -45,36 +47,60 @@ var regions = []Region{
 			EnabledAt:   "2023-01-01T00:00:00Z",
 		},
 	},
-	// Add more mock regions if needed later
+	// Disabled until it is enabled through the region API
+	{
+		ID:            "aws/us-west-2",
+		Name:          "us-west-2",
+		CloudProvider: "aws",
+		URL:           "http://cloud:3001/us-west-2",
+	},
 }
 
+var mu sync.Mutex
+
 func main() {
-	http.HandleFunc("/api/region", regionHandler)
+	http.HandleFunc("/api/region", regionHandler(&regions[0]))
+	http.HandleFunc("/us-west-2/api/region", regionHandler(&regions[1]))
 	http.HandleFunc("/api/cloud-regions", cloudRegionsHandler)
 
 	fmt.Println("Mock Cloud API server is running at http://localhost:3001")
 	log.Fatal(http.ListenAndServe(":3001", nil))
 }
 
-func regionHandler(w http.ResponseWriter, r *http.Request) {
-	switch r.Method {
-	case http.MethodGet:
-		mockRegion := CloudRegion{
-			RegionInfo: &RegionInfo{
-				SqlAddress:  "materialized:6877",
-				HttpAddress: "materialized:6875",
-				Resolvable:  true,
-				EnabledAt:   "2023-01-01T00:00:00Z",
-			},
+// regionHandler serves the region API of a single region. An enabled region
+// only becomes resolvable after it has been read once, so that clients have
+// to wait for it.
+func regionHandler(region *Region) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			if region.RegionInfo == nil {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(CloudRegion{RegionInfo: region.RegionInfo})
+			region.RegionInfo.Resolvable = true
+		case http.MethodPatch:
+			if region.RegionInfo == nil {
+				region.RegionInfo = &RegionInfo{
+					SqlAddress:  "materialized:6877",
+					HttpAddress: "materialized:6875",
+					Resolvable:  false,
+					EnabledAt:   time.Now().UTC().Format(time.RFC3339),
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(CloudRegion{RegionInfo: region.RegionInfo})
+		case http.MethodDelete:
+			region.RegionInfo = nil
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(mockRegion)
-	case http.MethodPatch:
-		w.WriteHeader(http.StatusOK)
-	case http.MethodDelete:
-		w.WriteHeader(http.StatusAccepted)
-	default:
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	}
 }
 
@@ -83,6 +109,8 @@ func cloudRegionsHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
+	mu.Lock()
+	defer mu.Unlock()
 	response := CloudProviderResponse{
 		Data: regions,
 	}
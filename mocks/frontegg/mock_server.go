@@ -21,12 +21,28 @@ type AppPassword struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
+type ServicePassword struct {
+	ClientID    string            `json:"clientId"`
+	Secret      string            `json:"secret"`
+	Description string            `json:"description"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	Metadata    map[string]string `json:"metadata"`
+	RoleIDs     []string          `json:"roleIds"`
+}
+
 type User struct {
-	ID                string `json:"id"`
-	Email             string `json:"email"`
-	ProfilePictureURL string `json:"profilePictureUrl"`
-	Verified          bool   `json:"verified"`
-	Metadata          string `json:"metadata"`
+	ID                string         `json:"id"`
+	Email             string         `json:"email"`
+	ProfilePictureURL string         `json:"profilePictureUrl"`
+	Verified          bool           `json:"verified"`
+	Metadata          string         `json:"metadata"`
+	Roles             []FronteggRole `json:"roles"`
+}
+
+type UserRequest struct {
+	Email    string   `json:"email"`
+	RoleIDs  []string `json:"roleIds"`
+	Metadata string   `json:"metadata"`
 }
 
 type FronteggRole struct {
@@ -34,6 +50,44 @@ type FronteggRole struct {
 	Name string `json:"name"`
 }
 
+type SSODomain struct {
+	ID          string `json:"id"`
+	Domain      string `json:"domain"`
+	Validated   bool   `json:"validated"`
+	SsoConfigId string `json:"ssoConfigId"`
+	TxtRecord   string `json:"txtRecord"`
+}
+
+type SSOConfig struct {
+	ID                string      `json:"id"`
+	Enabled           bool        `json:"enabled"`
+	SsoEndpoint       string      `json:"ssoEndpoint"`
+	PublicCertificate string      `json:"publicCertificate"`
+	SignRequest       bool        `json:"signRequest"`
+	AcsUrl            string      `json:"acsUrl"`
+	SpEntityId        string      `json:"spEntityId"`
+	Type              string      `json:"type"`
+	OidcClientId      string      `json:"oidcClientId"`
+	OidcSecret        string      `json:"oidcSecret"`
+	Domains           []SSODomain `json:"domains"`
+}
+
+type Group struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Roles       []FronteggRole `json:"roles"`
+}
+
+type SCIMConfig struct {
+	ID             string `json:"id"`
+	Source         string `json:"source"`
+	TenantID       string `json:"tenantId"`
+	ConnectionName string `json:"connectionName"`
+	CreatedAt      string `json:"createdAt"`
+	Token          string `json:"token,omitempty"`
+}
+
 type FronteggRolesResponse struct {
 	Items    []FronteggRole `json:"items"`
 	Metadata struct {
@@ -42,18 +96,36 @@ type FronteggRolesResponse struct {
 	} `json:"_metadata"`
 }
 
+var roles = []FronteggRole{
+	{ID: "1", Name: "Organization Admin"},
+	{ID: "2", Name: "Organization Member"},
+	{ID: "3", Name: "Billing Manager"},
+}
+
 var (
-	appPasswords = make(map[string]AppPassword)
-	users        = make(map[string]User)
-	mutex        = &sync.Mutex{}
+	appPasswords     = make(map[string]AppPassword)
+	servicePasswords = make(map[string]ServicePassword)
+	users            = make(map[string]User)
+	ssoConfigs       = make(map[string]*SSOConfig)
+	groups           = make(map[string]*Group)
+	scimConfigs      = make(map[string]SCIMConfig)
+	mutex            = &sync.Mutex{}
 )
 
 func main() {
 	http.HandleFunc("/identity/resources/auth/v1/api-token", handleTokenRequest)
 	http.HandleFunc("/identity/resources/users/api-tokens/v1", handleAppPasswords)
+	http.HandleFunc("/identity/resources/tenants/api-tokens/v1", handleServicePasswords)
+	http.HandleFunc("/identity/resources/tenants/api-tokens/v1/", handleServicePasswords)
 	http.HandleFunc("/identity/resources/users/v1/", handleUserRequest)
 	http.HandleFunc("/identity/resources/users/v2", handleUserRequest)
 	http.HandleFunc("/identity/resources/roles/v2", handleRolesRequest)
+	http.HandleFunc("/frontegg/team/resources/sso/v1/configurations", handleSSORequest)
+	http.HandleFunc("/frontegg/team/resources/sso/v1/configurations/", handleSSORequest)
+	http.HandleFunc("/frontegg/identity/resources/groups/v1", handleGroupRequest)
+	http.HandleFunc("/frontegg/identity/resources/groups/v1/", handleGroupRequest)
+	http.HandleFunc("/frontegg/directory/resources/v1/configurations/scim2", handleSCIMRequest)
+	http.HandleFunc("/frontegg/directory/resources/v1/configurations/scim2/", handleSCIMRequest)
 
 	fmt.Println("Mock Frontegg server is running at http://localhost:3000")
 	log.Fatal(http.ListenAndServe(":3000", nil))
@@ -61,6 +133,10 @@ func main() {
 
 func handleUserRequest(w http.ResponseWriter, r *http.Request) {
 	logRequest(r)
+	if strings.HasSuffix(r.URL.Path, "/roles") {
+		handleUserRolesRequest(w, r)
+		return
+	}
 	switch r.Method {
 	case http.MethodGet:
 		getUser(w, r)
@@ -68,11 +144,109 @@ func handleUserRequest(w http.ResponseWriter, r *http.Request) {
 		deleteUser(w, r)
 	case http.MethodPost:
 		createUser(w, r)
+	case http.MethodPut:
+		updateUser(w, r)
 	default:
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	}
 }
 
+// handleUserRolesRequest assigns roles to a user on POST and removes them on DELETE.
+func handleUserRolesRequest(w http.ResponseWriter, r *http.Request) {
+	userID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/identity/resources/users/v1/"), "/roles")
+
+	var req UserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	user, ok := users[userID]
+	if !ok {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		for _, role := range rolesByID(req.RoleIDs) {
+			if !hasRole(user, role.ID) {
+				user.Roles = append(user.Roles, role)
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		var kept []FronteggRole
+		for _, role := range user.Roles {
+			if !contains(req.RoleIDs, role.ID) {
+				kept = append(kept, role)
+			}
+		}
+		user.Roles = kept
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	users[userID] = user
+}
+
+func updateUser(w http.ResponseWriter, r *http.Request) {
+	userID := strings.TrimPrefix(r.URL.Path, "/identity/resources/users/v1/")
+
+	var req UserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	user, ok := users[userID]
+	if !ok {
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	}
+	user.Metadata = req.Metadata
+	users[userID] = user
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(user)
+}
+
+func rolesByID(roleIDs []string) []FronteggRole {
+	var result []FronteggRole
+	for _, role := range roles {
+		if contains(roleIDs, role.ID) {
+			result = append(result, role)
+		}
+	}
+	return result
+}
+
+func hasRole(user User, roleID string) bool {
+	for _, role := range user.Roles {
+		if role.ID == roleID {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func getUser(w http.ResponseWriter, r *http.Request) {
 	userID := strings.TrimPrefix(r.URL.Path, "/identity/resources/users/v1/")
 	if userID == "" {
@@ -80,7 +254,9 @@ func getUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	mutex.Lock()
 	user, ok := users[userID]
+	mutex.Unlock()
 	if !ok {
 		http.Error(w, "User not found", http.StatusNotFound)
 		return
@@ -91,14 +267,19 @@ func getUser(w http.ResponseWriter, r *http.Request) {
 }
 
 func createUser(w http.ResponseWriter, r *http.Request) {
-	var newUser User
-	if err := json.NewDecoder(r.Body).Decode(&newUser); err != nil {
+	var req UserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
 	userID := generateUserID()
-	newUser.ID = userID
+	newUser := User{
+		ID:       userID,
+		Email:    req.Email,
+		Metadata: req.Metadata,
+		Roles:    rolesByID(req.RoleIDs),
+	}
 
 	// Store the new user
 	mutex.Lock()
@@ -189,13 +370,45 @@ func handleAppPasswords(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func handleRolesRequest(w http.ResponseWriter, r *http.Request) {
+func handleServicePasswords(w http.ResponseWriter, r *http.Request) {
 	logRequest(r)
-	roles := []FronteggRole{
-		{ID: "1", Name: "Organization Admin"},
-		{ID: "2", Name: "Organization Member"},
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	clientID := strings.Trim(strings.TrimPrefix(r.URL.Path, "/identity/resources/tenants/api-tokens/v1"), "/")
+
+	switch {
+	case clientID == "" && r.Method == http.MethodPost:
+		var password ServicePassword
+		if err := json.NewDecoder(r.Body).Decode(&password); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		password.ClientID = generateClientID()
+		password.Secret = generateSecret()
+		password.CreatedAt = time.Now()
+		servicePasswords[password.ClientID] = password
+		sendResponse(w, http.StatusCreated, password)
+	case clientID == "" && r.Method == http.MethodGet:
+		passwords := make([]ServicePassword, 0, len(servicePasswords))
+		for _, password := range servicePasswords {
+			passwords = append(passwords, password)
+		}
+		sendResponse(w, http.StatusOK, passwords)
+	case clientID != "" && r.Method == http.MethodDelete:
+		if _, ok := servicePasswords[clientID]; !ok {
+			http.Error(w, "App password not found", http.StatusNotFound)
+			return
+		}
+		delete(servicePasswords, clientID)
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	}
+}
 
+func handleRolesRequest(w http.ResponseWriter, r *http.Request) {
+	logRequest(r)
 	response := FronteggRolesResponse{
 		Items: roles,
 		Metadata: struct {
@@ -296,3 +509,228 @@ func logRequest(r *http.Request) {
 		}
 	}
 }
+
+func handleSSORequest(w http.ResponseWriter, r *http.Request) {
+	logRequest(r)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/frontegg/team/resources/sso/v1/configurations"), "/")
+	parts := strings.Split(path, "/")
+
+	switch {
+	case path == "" && r.Method == http.MethodGet:
+		list := []SSOConfig{}
+		for _, config := range ssoConfigs {
+			list = append(list, *config)
+		}
+		sendResponse(w, http.StatusOK, list)
+	case path == "" && r.Method == http.MethodPost:
+		var config SSOConfig
+		if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		config.ID = fmt.Sprintf("sso-%d", time.Now().UnixNano())
+		config.AcsUrl = "https://auth.materialize.com/auth/saml/callback"
+		config.SpEntityId = "https://auth.materialize.com/" + config.ID
+		config.OidcSecret = ""
+		config.Domains = []SSODomain{}
+		ssoConfigs[config.ID] = &config
+		sendResponse(w, http.StatusCreated, config)
+	case len(parts) == 1:
+		config, ok := ssoConfigs[parts[0]]
+		if !ok {
+			http.Error(w, "SSO configuration not found", http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			var update SSOConfig
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			config.Enabled = update.Enabled
+			config.SsoEndpoint = update.SsoEndpoint
+			config.PublicCertificate = update.PublicCertificate
+			config.SignRequest = update.SignRequest
+			config.OidcClientId = update.OidcClientId
+			sendResponse(w, http.StatusOK, config)
+		case http.MethodDelete:
+			delete(ssoConfigs, config.ID)
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	case len(parts) >= 2 && parts[1] == "domains":
+		config, ok := ssoConfigs[parts[0]]
+		if !ok {
+			http.Error(w, "SSO configuration not found", http.StatusNotFound)
+			return
+		}
+		handleSSODomainRequest(w, r, config, parts[2:])
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+func handleSSODomainRequest(w http.ResponseWriter, r *http.Request, config *SSOConfig, parts []string) {
+	if len(parts) == 0 {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var domain SSODomain
+		if err := json.NewDecoder(r.Body).Decode(&domain); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		domain.ID = fmt.Sprintf("domain-%d", time.Now().UnixNano())
+		domain.SsoConfigId = config.ID
+		domain.TxtRecord = "_saml-domain-challenge." + domain.ID
+		config.Domains = append(config.Domains, domain)
+		sendResponse(w, http.StatusCreated, domain)
+		return
+	}
+
+	for i := range config.Domains {
+		if config.Domains[i].ID != parts[0] {
+			continue
+		}
+		switch {
+		case len(parts) == 1 && r.Method == http.MethodDelete:
+			config.Domains = append(config.Domains[:i], config.Domains[i+1:]...)
+			w.WriteHeader(http.StatusOK)
+		case len(parts) == 2 && parts[1] == "validate" && r.Method == http.MethodPut:
+			// Domains under example.com never have the TXT record
+			if strings.HasSuffix(config.Domains[i].Domain, "example.com") {
+				http.Error(w, "TXT record not found", http.StatusBadRequest)
+				return
+			}
+			config.Domains[i].Validated = true
+			sendResponse(w, http.StatusOK, config.Domains[i])
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+	}
+	http.Error(w, "Domain not found", http.StatusNotFound)
+}
+
+func handleGroupRequest(w http.ResponseWriter, r *http.Request) {
+	logRequest(r)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/frontegg/identity/resources/groups/v1"), "/")
+	parts := strings.Split(path, "/")
+
+	if path == "" {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var group Group
+		if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		group.ID = fmt.Sprintf("group-%d", time.Now().UnixNano())
+		group.Roles = []FronteggRole{}
+		groups[group.ID] = &group
+		sendResponse(w, http.StatusCreated, group)
+		return
+	}
+
+	group, ok := groups[parts[0]]
+	if !ok {
+		http.Error(w, "Group not found", http.StatusNotFound)
+		return
+	}
+
+	if len(parts) == 2 && parts[1] == "roles" {
+		var rolesReq struct {
+			RoleIDs []string `json:"roleIds"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&rolesReq); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodPost:
+			group.Roles = append(group.Roles, rolesByID(rolesReq.RoleIDs)...)
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			kept := []FronteggRole{}
+			for _, role := range group.Roles {
+				if !contains(rolesReq.RoleIDs, role.ID) {
+					kept = append(kept, role)
+				}
+			}
+			group.Roles = kept
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		sendResponse(w, http.StatusOK, group)
+	case http.MethodPatch:
+		var update Group
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		group.Name = update.Name
+		group.Description = update.Description
+		sendResponse(w, http.StatusOK, group)
+	case http.MethodDelete:
+		delete(groups, group.ID)
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleSCIMRequest(w http.ResponseWriter, r *http.Request) {
+	logRequest(r)
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/frontegg/directory/resources/v1/configurations/scim2"), "/")
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		list := []SCIMConfig{}
+		for _, config := range scimConfigs {
+			list = append(list, config)
+		}
+		sendResponse(w, http.StatusOK, list)
+	case id == "" && r.Method == http.MethodPost:
+		var config SCIMConfig
+		if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		config.ID = fmt.Sprintf("scim-%d", time.Now().UnixNano())
+		config.TenantID = "mock-tenant-id"
+		config.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+		scimConfigs[config.ID] = config
+		// The token is only returned on create
+		config.Token = generateSecret()
+		sendResponse(w, http.StatusCreated, config)
+	case id != "" && r.Method == http.MethodDelete:
+		if _, ok := scimConfigs[id]; !ok {
+			http.Error(w, "SCIM configuration not found", http.StatusNotFound)
+			return
+		}
+		delete(scimConfigs, id)
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
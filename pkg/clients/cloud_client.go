@@ -1,6 +1,7 @@
 package clients
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -93,6 +94,11 @@ func (c *CloudAPIClient) GetRegionDetails(ctx context.Context, provider CloudPro
 	}
 	defer resp.Body.Close()
 
+	// The region is not enabled
+	if resp.StatusCode == http.StatusNoContent {
+		return &CloudRegion{}, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
@@ -113,23 +119,27 @@ func (c *CloudAPIClient) GetRegionDetails(ctx context.Context, provider CloudPro
 	return &region, nil
 }
 
-// GetHost retrieves the SQL address for a specified region
-func (c *CloudAPIClient) GetHost(ctx context.Context, regionID string) (string, error) {
+// GetProvider retrieves the cloud provider for a specified region
+func (c *CloudAPIClient) GetProvider(ctx context.Context, regionID string) (*CloudProvider, error) {
 	providers, err := c.ListCloudProviders(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var provider *CloudProvider
 	for _, p := range providers {
 		if p.ID == regionID {
-			provider = &p
-			break
+			return &p, nil
 		}
 	}
 
-	if provider == nil {
-		return "", fmt.Errorf("provider for region '%s' not found", regionID)
+	return nil, fmt.Errorf("provider for region '%s' not found", regionID)
+}
+
+// GetHost retrieves the SQL address for a specified region
+func (c *CloudAPIClient) GetHost(ctx context.Context, regionID string) (string, error) {
+	provider, err := c.GetProvider(ctx, regionID)
+	if err != nil {
+		return "", err
 	}
 
 	region, err := c.GetRegionDetails(ctx, *provider)
@@ -144,6 +154,71 @@ func (c *CloudAPIClient) GetHost(ctx context.Context, regionID string) (string,
 	return region.RegionInfo.SqlAddress, nil
 }
 
+// EnableRegion enables a region. The region is usually not resolvable right
+// away, callers should poll GetRegionDetails until it is.
+func (c *CloudAPIClient) EnableRegion(ctx context.Context, provider CloudProvider) (*CloudRegion, error) {
+	regionEndpoint := fmt.Sprintf("%s/api/region", provider.Url)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, regionEndpoint, bytes.NewBufferString("{}"))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.FronteggClient.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error enabling region: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("cloud API returned non-2xx status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var region CloudRegion
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &region); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("[DEBUG] Enable region response body: %+v\n", region)
+
+	return &region, nil
+}
+
+// DisableRegion disables a region. The region is torn down asynchronously,
+// callers should poll GetRegionDetails until it no longer has region info.
+func (c *CloudAPIClient) DisableRegion(ctx context.Context, provider CloudProvider) error {
+	regionEndpoint := fmt.Sprintf("%s/api/region", provider.Url)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, regionEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	resp, err := c.FronteggClient.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error disabling region: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %v", err)
+		}
+		return fmt.Errorf("cloud API returned non-2xx status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
 func SplitHostPort(hostPortStr string) (host string, port int, err error) {
 	parts := strings.Split(hostPortStr, ":")
 	switch len(parts) {
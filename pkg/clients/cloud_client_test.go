@@ -217,3 +217,121 @@ func TestNewCloudAPIClient(t *testing.T) {
 	require.NotNil(t, cloudAPIClient.HTTPClient)
 	require.Equal(t, anotherCustomEndpoint, cloudAPIClient.Endpoint)
 }
+
+func TestCloudAPIClient_GetRegionDetails_NotEnabled(t *testing.T) {
+	mockService := &MockFronteggService{
+		// The Cloud API responds with no content for regions that are not enabled
+		MockResponseStatus: http.StatusNoContent,
+	}
+	mockClient := &http.Client{Transport: mockService}
+	apiClient := &CloudAPIClient{
+		FronteggClient: &FronteggClient{HTTPClient: mockClient},
+		Endpoint:       "http://mockendpoint.com",
+	}
+	provider := CloudProvider{
+		ID:   "aws/us-east-1",
+		Name: "us-east-1",
+		Url:  "http://mockendpoint.com",
+	}
+
+	region, err := apiClient.GetRegionDetails(context.Background(), provider)
+	require.NoError(t, err)
+	require.Nil(t, region.RegionInfo)
+}
+
+func TestCloudAPIClient_GetProvider(t *testing.T) {
+	mockService := &MockFronteggService{
+		MockResponseStatus: http.StatusOK,
+	}
+	mockClient := &http.Client{Transport: mockService}
+	apiClient := &CloudAPIClient{
+		FronteggClient: &FronteggClient{HTTPClient: mockClient},
+		Endpoint:       "http://mockendpoint.com",
+	}
+
+	provider, err := apiClient.GetProvider(context.Background(), "aws/eu-west-1")
+	require.NoError(t, err)
+	require.Equal(t, "eu-west-1", provider.Name)
+
+	_, err = apiClient.GetProvider(context.Background(), "non-existent-region")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "provider for region 'non-existent-region' not found")
+}
+
+func TestCloudAPIClient_EnableRegion(t *testing.T) {
+	mockService := &MockFronteggService{
+		MockResponseStatus: http.StatusOK,
+	}
+	mockClient := &http.Client{Transport: mockService}
+	apiClient := &CloudAPIClient{
+		FronteggClient: &FronteggClient{HTTPClient: mockClient},
+		Endpoint:       "http://mockendpoint.com",
+	}
+	provider := CloudProvider{
+		ID:   "aws/us-east-1",
+		Name: "us-east-1",
+		Url:  "http://mockendpoint.com",
+	}
+
+	region, err := apiClient.EnableRegion(context.Background(), provider)
+	require.NoError(t, err)
+	require.Equal(t, "sql.materialize.com", region.RegionInfo.SqlAddress)
+}
+
+func TestCloudAPIClient_EnableRegion_ErrorResponse(t *testing.T) {
+	mockService := &MockFronteggService{
+		MockResponseStatus: http.StatusInternalServerError,
+	}
+	mockClient := &http.Client{Transport: mockService}
+	apiClient := &CloudAPIClient{
+		FronteggClient: &FronteggClient{HTTPClient: mockClient},
+		Endpoint:       "http://mockendpoint.com",
+	}
+	provider := CloudProvider{
+		ID:   "aws/us-east-1",
+		Name: "us-east-1",
+		Url:  "http://mockendpoint.com",
+	}
+
+	_, err := apiClient.EnableRegion(context.Background(), provider)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "non-2xx status code: 500")
+}
+
+func TestCloudAPIClient_DisableRegion(t *testing.T) {
+	mockService := &MockFronteggService{
+		MockResponseStatus: http.StatusAccepted,
+	}
+	mockClient := &http.Client{Transport: mockService}
+	apiClient := &CloudAPIClient{
+		FronteggClient: &FronteggClient{HTTPClient: mockClient},
+		Endpoint:       "http://mockendpoint.com",
+	}
+	provider := CloudProvider{
+		ID:   "aws/us-east-1",
+		Name: "us-east-1",
+		Url:  "http://mockendpoint.com",
+	}
+
+	err := apiClient.DisableRegion(context.Background(), provider)
+	require.NoError(t, err)
+}
+
+func TestCloudAPIClient_DisableRegion_ErrorResponse(t *testing.T) {
+	mockService := &MockFronteggService{
+		MockResponseStatus: http.StatusForbidden,
+	}
+	mockClient := &http.Client{Transport: mockService}
+	apiClient := &CloudAPIClient{
+		FronteggClient: &FronteggClient{HTTPClient: mockClient},
+		Endpoint:       "http://mockendpoint.com",
+	}
+	provider := CloudProvider{
+		ID:   "aws/us-east-1",
+		Name: "us-east-1",
+		Url:  "http://mockendpoint.com",
+	}
+
+	err := apiClient.DisableRegion(context.Background(), provider)
+	require.Error(t, err)
+}
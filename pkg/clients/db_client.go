@@ -3,6 +3,8 @@ package clients
 import (
 	"fmt"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/jmoiron/sqlx"
@@ -12,7 +14,16 @@ type DBClient struct {
 	*sqlx.DB
 }
 
-func NewDBClient(host, user, password string, port int, database, application_name_suffix, version, sslmode string) (*DBClient, diag.Diagnostics) {
+// DBPoolConfig holds the connection pool settings of a database client. Zero
+// values keep the defaults of database/sql.
+type DBPoolConfig struct {
+	MaxOpenConns    int
+	ConnMaxIdleTime time.Duration
+	ConnMaxLifetime time.Duration
+	ConnectTimeout  time.Duration
+}
+
+func NewDBClient(host, user, password string, port int, database, application_name_suffix, version, sslmode string, pool DBPoolConfig) (*DBClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	application_name := fmt.Sprintf("terraform-provider-materialize v%s", version)
@@ -20,7 +31,7 @@ func NewDBClient(host, user, password string, port int, database, application_na
 		application_name += " " + application_name_suffix
 	}
 
-	connStr := buildConnectionString(host, user, password, port, database, sslmode, application_name)
+	connStr := buildConnectionString(host, user, password, port, database, sslmode, application_name, pool.ConnectTimeout)
 	db, err := sqlx.Open("pgx", connStr)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -30,19 +41,35 @@ func NewDBClient(host, user, password string, port int, database, application_na
 		})
 		return nil, diags
 	}
+
+	if pool.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(pool.MaxOpenConns)
+	}
+	if pool.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(pool.ConnMaxIdleTime)
+	}
+	if pool.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	}
+
 	return &DBClient{DB: db}, diags
 }
 
-func buildConnectionString(host, user, password string, port int, database, sslmode, application_name string) string {
+func buildConnectionString(host, user, password string, port int, database, sslmode, application_name string, connectTimeout time.Duration) string {
+	values := url.Values{
+		"application_name": {application_name},
+		"sslmode":          {sslmode},
+	}
+	if connectTimeout > 0 {
+		values.Set("connect_timeout", strconv.Itoa(int(connectTimeout.Seconds())))
+	}
+
 	url := &url.URL{
-		Scheme: "postgres",
-		User:   url.UserPassword(user, password),
-		Host:   fmt.Sprintf("%s:%d", host, port),
-		Path:   database,
-		RawQuery: url.Values{
-			"application_name": {application_name},
-			"sslmode":          {sslmode},
-		}.Encode(),
+		Scheme:   "postgres",
+		User:     url.UserPassword(user, password),
+		Host:     fmt.Sprintf("%s:%d", host, port),
+		Path:     database,
+		RawQuery: values.Encode(),
 	}
 
 	return url.String()
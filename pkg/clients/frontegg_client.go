@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -25,45 +26,75 @@ type FronteggClient struct {
 	Endpoint    string
 	TokenExpiry time.Time
 	Password    string
+
+	// mu guards the token, which is refreshed by the transport of HTTPClient
+	// while requests run concurrently.
+	mu sync.Mutex
 }
 
 // NewFronteggClient function for initializing a new Frontegg client with an auth token
 func NewFronteggClient(ctx context.Context, password, endpoint string) (*FronteggClient, error) {
-	token, email, tokenExpiry, err := getToken(ctx, password, endpoint)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get token: %v", err)
+	client := &FronteggClient{
+		Endpoint: endpoint,
+		Password: password,
 	}
 
-	transport := &tokenTransport{
-		Token:     token,
-		Transport: http.DefaultTransport,
+	if err := client.refreshToken(ctx); err != nil {
+		return nil, err
 	}
 
-	client := &http.Client{Transport: transport}
+	client.HTTPClient = &http.Client{
+		Transport: &tokenTransport{
+			Client:    client,
+			Transport: newRetryTransport(http.DefaultTransport),
+		},
+	}
 
-	return &FronteggClient{
-		HTTPClient:  client,
-		Token:       token,
-		Email:       email,
-		Endpoint:    endpoint,
-		TokenExpiry: tokenExpiry.Add(-time.Duration(0.5*float64(time.Until(tokenExpiry).Nanoseconds())) * time.Nanosecond),
-		Password:    password,
-	}, nil
+	return client, nil
 }
 
-// tokenTransport struct to add the Authorization header to each request
+// tokenTransport struct to add the Authorization header to each request. The
+// token is refreshed before it expires and once more when a request is
+// rejected with a 401 status code.
 type tokenTransport struct {
-	Token     string
+	Client    *FronteggClient
 	Transport http.RoundTripper
 }
 
 // RoundTrip method to execute the request with the token
 func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Client.validToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
 	req2 := cloneRequest(req)
-	req2.Header.Set("Authorization", "Bearer "+t.Token)
-	return t.Transport.RoundTrip(req2)
+	req2.Header.Set("Authorization", "Bearer "+token)
+	resp, err := t.Transport.RoundTrip(req2)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The token can be revoked before it expires, retry once with a new token
+	req3, err := rewindRequest(req)
+	if err != nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	token, err = t.Client.replaceToken(req.Context(), token)
+	if err != nil {
+		return nil, err
+	}
+
+	req3.Header.Set("Authorization", "Bearer "+token)
+	return t.Transport.RoundTrip(req3)
 }
 
+// tokenClient retries the token requests that are rate limited or fail with
+// a server error.
+var tokenClient = &http.Client{Transport: newRetryTransport(http.DefaultTransport)}
+
 // GetToken function to authenticate with the Frontegg API and retrieve a token
 func getToken(ctx context.Context, password string, endpoint string) (string, string, time.Time, error) {
 	clientId, secretKey, err := parseAppPassword(password)
@@ -88,7 +119,7 @@ func getToken(ctx context.Context, password string, endpoint string) (string, st
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := tokenClient.Do(req)
 	if err != nil {
 		return "", "", time.Time{}, err
 	}
@@ -143,11 +174,15 @@ func getToken(ctx context.Context, password string, endpoint string) (string, st
 
 // Get the token from the FronteggClient
 func (c *FronteggClient) GetToken() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.Token
 }
 
 // Get the email from the FronteggClient
 func (c *FronteggClient) GetEmail() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.Email
 }
 
@@ -158,6 +193,8 @@ func (c *FronteggClient) GetEndpoint() string {
 
 // Get the token expiry from the FronteggClient
 func (c *FronteggClient) GetTokenExpiry() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.TokenExpiry
 }
 
@@ -214,7 +251,8 @@ func formatDashlessUuid(dashlessUuid string) string {
 	return strings.Join(parts, "-")
 }
 
-func (c *FronteggClient) NeedsTokenRefresh() error {
+// needsTokenRefresh reports if the token expired. The caller must hold c.mu.
+func (c *FronteggClient) needsTokenRefresh() error {
 	if time.Now().After(c.TokenExpiry) {
 		return fmt.Errorf("token expired and needs refresh")
 	}
@@ -222,23 +260,51 @@ func (c *FronteggClient) NeedsTokenRefresh() error {
 }
 
 func (c *FronteggClient) RefreshToken() error {
-	log.Printf("[DEBUG] Refreshing Frontegg: %v\n", c)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.refreshToken(context.Background())
+}
 
-	token, email, tokenExpiry, err := getToken(context.Background(), c.Password, c.Endpoint)
-	if err != nil {
-		return fmt.Errorf("failed to get token: %v", err)
+// validToken returns the token, refreshing it first if it expired.
+func (c *FronteggClient) validToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.needsTokenRefresh(); err != nil {
+		if err := c.refreshToken(ctx); err != nil {
+			return "", err
+		}
 	}
+	return c.Token, nil
+}
 
-	transport := &tokenTransport{
-		Token:     token,
-		Transport: http.DefaultTransport,
+// replaceToken refreshes a token that was rejected. Concurrent requests
+// rejected with the same token share a single refresh.
+func (c *FronteggClient) replaceToken(ctx context.Context, rejected string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Token == rejected {
+		if err := c.refreshToken(ctx); err != nil {
+			return "", err
+		}
 	}
+	return c.Token, nil
+}
+
+// refreshToken gets a new token. The caller must hold c.mu once the client
+// is shared.
+func (c *FronteggClient) refreshToken(ctx context.Context) error {
+	log.Printf("[DEBUG] Refreshing Frontegg token for %s\n", c.Endpoint)
 
-	client := &http.Client{Transport: transport}
+	token, email, tokenExpiry, err := getToken(ctx, c.Password, c.Endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to get token: %v", err)
+	}
 
-	c.HTTPClient = client
 	c.Token = token
 	c.Email = email
+	// Refresh the token once half of its lifetime has passed
 	c.TokenExpiry = tokenExpiry.Add(-time.Duration(0.5*float64(time.Until(tokenExpiry).Nanoseconds())) * time.Nanosecond)
 
 	return nil
@@ -3,9 +3,13 @@ package clients
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -89,7 +93,7 @@ func TestFronteggClient_TokenRefresh(t *testing.T) {
 	require.NotEmpty(t, fronteggClient.Token, "Token should be set correctly in the Frontegg client")
 
 	// Verify that the client does not detect the need for token refresh immediately
-	require.NoError(t, fronteggClient.NeedsTokenRefresh(), "Token should not be considered expired")
+	require.NoError(t, fronteggClient.needsTokenRefresh(), "Token should not be considered expired")
 }
 
 func generateValidJWTToken() string {
@@ -117,7 +121,7 @@ func TestFronteggClient_NeedsTokenRefresh(t *testing.T) {
 	}
 
 	// Verify that the client correctly detects the need for token refresh
-	require.Error(t, fronteggClient.NeedsTokenRefresh(), "Token should be considered expired and require refresh")
+	require.Error(t, fronteggClient.needsTokenRefresh(), "Token should be considered expired and require refresh")
 }
 
 func TestParseAppPassword(t *testing.T) {
@@ -131,3 +135,107 @@ func TestParseAppPassword(t *testing.T) {
 	_, _, err = parseAppPassword(invalidPassword)
 	require.Error(t, err, "Parsing invalid password should result in an error")
 }
+
+// newTokenServer returns a mock Frontegg API that issues a new token on every
+// authentication and only accepts the latest token.
+func newTokenServer(t *testing.T, tokenRequests *int32) *httptest.Server {
+	var mu sync.Mutex
+	var latest string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/identity/resources/auth/v1/api-token" {
+			atomic.AddInt32(tokenRequests, 1)
+			latest = generateValidJWTToken() + fmt.Sprint(atomic.LoadInt32(tokenRequests))
+			json.NewEncoder(w).Encode(map[string]string{"accessToken": latest})
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+latest {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFronteggClient_RefreshBeforeExpiry(t *testing.T) {
+	var tokenRequests int32
+	server := newTokenServer(t, &tokenRequests)
+
+	fronteggClient, err := NewFronteggClient(context.Background(), "mzp_"+strings.Repeat("a", 64), server.URL)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), tokenRequests)
+
+	// Expire the token, the next requests refresh it once
+	fronteggClient.TokenExpiry = time.Now().Add(-time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := fronteggClient.HTTPClient.Get(server.URL + "/api")
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(2), tokenRequests)
+}
+
+func TestFronteggClient_RetryOnUnauthorized(t *testing.T) {
+	var tokenRequests int32
+	server := newTokenServer(t, &tokenRequests)
+
+	fronteggClient, err := NewFronteggClient(context.Background(), "mzp_"+strings.Repeat("a", 64), server.URL)
+	require.NoError(t, err)
+
+	// Revoke the token by issuing a new one behind the back of the client
+	resp, err := http.Post(server.URL+"/identity/resources/auth/v1/api-token", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	resp, err = fronteggClient.HTTPClient.Post(server.URL+"/api", "application/json", strings.NewReader(`{"name":"test"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	require.Equal(t, `{"name":"test"}`, string(body), "The request body should be sent again")
+	require.Equal(t, int32(3), tokenRequests)
+}
+
+// Run with -race to check that reading the client while its token is
+// refreshed is synchronized.
+func TestFronteggClient_ConcurrentRefresh(t *testing.T) {
+	var tokenRequests int32
+	server := newTokenServer(t, &tokenRequests)
+
+	fronteggClient, err := NewFronteggClient(context.Background(), "mzp_"+strings.Repeat("a", 64), server.URL)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, fronteggClient.RefreshToken())
+		}()
+		go func() {
+			defer wg.Done()
+			require.Equal(t, "test@example.com", fronteggClient.GetEmail())
+			require.False(t, fronteggClient.GetTokenExpiry().IsZero())
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(6), tokenRequests)
+}
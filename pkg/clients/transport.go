@@ -0,0 +1,118 @@
+package clients
+
+import (
+	"errors"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 4
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 15 * time.Second
+)
+
+// retryTransport retries requests that are rate limited or fail with a
+// server error, waiting with exponential backoff and jitter between attempts.
+// POST requests are only retried when rate limited, as a server error does not
+// tell whether the request was applied.
+type retryTransport struct {
+	Transport  http.RoundTripper
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+func newRetryTransport(transport http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		Transport:  transport,
+		MaxRetries: defaultMaxRetries,
+		MinBackoff: defaultMinBackoff,
+		MaxBackoff: defaultMaxBackoff,
+	}
+}
+
+// RoundTrip method to execute the request, retrying it if needed
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Transport.RoundTrip(req)
+
+	for attempt := 0; attempt < t.MaxRetries && err == nil && t.retryable(req, resp); attempt++ {
+		retry, rewindErr := rewindRequest(req)
+		if rewindErr != nil {
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt, resp)
+		log.Printf("[DEBUG] %s %s returned %s, retrying in %s", req.Method, req.URL.Path, resp.Status, wait)
+
+		// Drain the body so the connection can be reused
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		resp, err = t.Transport.RoundTrip(retry)
+	}
+
+	return resp, err
+}
+
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response) bool {
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return req.Method != http.MethodPost
+	default:
+		return false
+	}
+}
+
+// backoff returns the time to wait before the next attempt. The Retry-After
+// header of a response takes precedence over the exponential backoff.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s >= 0 {
+		if wait := time.Duration(s) * time.Second; wait < t.MaxBackoff {
+			return wait
+		}
+		return t.MaxBackoff
+	}
+
+	wait := t.MinBackoff << attempt
+	if wait <= 0 || wait > t.MaxBackoff {
+		wait = t.MaxBackoff
+	}
+
+	// Wait between half and all of the backoff so concurrent requests spread out
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// rewindRequest clones a request with a fresh copy of its body so it can be
+// sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	req2 := cloneRequest(req)
+	if req.Body == nil || req.Body == http.NoBody {
+		return req2, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, errors.New("request body cannot be sent again")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req2.Body = body
+	return req2, nil
+}
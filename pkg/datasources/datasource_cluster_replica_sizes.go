@@ -0,0 +1,99 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ClusterReplicaSizes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: clusterReplicaSizesRead,
+		Schema: map[string]*schema.Schema{
+			"sizes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The replica sizes available in the region, from the smallest to the largest",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the size.",
+						},
+						"processes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of processes in a replica of the size.",
+						},
+						"workers": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of worker threads per process.",
+						},
+						"cpu_nano_cores": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The CPU allocation per process, in billionths of a vCPU core.",
+						},
+						"memory_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The RAM allocation per process, in bytes.",
+						},
+						"disk_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The disk allocation per process, in bytes.",
+						},
+						"credits_per_hour": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The number of compute credits a replica of the size consumes per hour.",
+						},
+					},
+				},
+			},
+			"region": RegionSchema(),
+		},
+	}
+}
+
+func clusterReplicaSizesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dataSource, err := materialize.ListClusterReplicaSizes(metaDb)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sizeFormats := []map[string]interface{}{}
+	for _, p := range dataSource {
+		sizeMap := map[string]interface{}{}
+
+		sizeMap["size"] = p.Size.String
+		sizeMap["processes"] = p.Processes.Int64
+		sizeMap["workers"] = p.Workers.Int64
+		sizeMap["cpu_nano_cores"] = p.CpuNanoCores.Int64
+		sizeMap["memory_bytes"] = p.MemoryBytes.Int64
+		sizeMap["disk_bytes"] = p.DiskBytes.Int64
+		sizeMap["credits_per_hour"] = p.CreditsPerHour.Float64
+
+		sizeFormats = append(sizeFormats, sizeMap)
+	}
+
+	if err := d.Set("sizes", sizeFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), "cluster_replica_sizes"))
+	return diags
+}
@@ -0,0 +1,35 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterReplicaSizesDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{}
+	d := schema.TestResourceDataRaw(t, ClusterReplicaSizes().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockClusterReplicaSizeScan(mock)
+
+		if err := clusterReplicaSizesRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:cluster_replica_sizes", d.Id())
+		r.Equal("25cc", d.Get("sizes.0.size"))
+		r.Equal(2, d.Get("sizes.1.workers"))
+		r.Equal(15032385536, d.Get("sizes.1.memory_bytes"))
+		r.Equal(0.25, d.Get("sizes.0.credits_per_hour"))
+	})
+}
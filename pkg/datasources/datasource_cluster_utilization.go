@@ -0,0 +1,122 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ClusterUtilization() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: clusterUtilizationRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit the replicas to the replicas of the cluster.",
+			},
+			"replicas": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The utilization and status of the cluster replicas. The utilization of a replica with several processes is the utilization of its busiest process. The `status` is `not-ready` when any process is not ready, with the `reason`, such as `oom-killed`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cpu_percent": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"memory_percent": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"disk_percent": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": RegionSchema(),
+		},
+	}
+}
+
+func clusterUtilizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	replicas, err := materialize.ListClusterReplicas(metaDb)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	utilization, err := materialize.ListClusterReplicaUtilization(metaDb)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	utilizationById := map[string]materialize.ClusterReplicaUtilizationParams{}
+	for _, u := range utilization {
+		utilizationById[u.ReplicaId.String] = u
+	}
+
+	clusterName := d.Get("cluster_name").(string)
+	replicaFormats := []map[string]interface{}{}
+	for _, p := range replicas {
+		if clusterName != "" && p.ClusterName.String != clusterName {
+			continue
+		}
+		u := utilizationById[p.ReplicaId.String]
+
+		replicaMap := map[string]interface{}{}
+
+		replicaMap["id"] = p.ReplicaId.String
+		replicaMap["name"] = p.ReplicaName.String
+		replicaMap["cluster"] = p.ClusterName.String
+		replicaMap["size"] = p.Size.String
+		replicaMap["cpu_percent"] = u.CpuPercent.Float64
+		replicaMap["memory_percent"] = u.MemoryPercent.Float64
+		replicaMap["disk_percent"] = u.DiskPercent.Float64
+		replicaMap["status"] = u.Status.String
+		replicaMap["reason"] = u.Reason.String
+
+		replicaFormats = append(replicaFormats, replicaMap)
+	}
+
+	if err := d.Set("replicas", replicaFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), "cluster_utilization"))
+	return diags
+}
@@ -0,0 +1,55 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterUtilizationDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{"cluster_name": "cluster"}
+	d := schema.TestResourceDataRaw(t, ClusterUtilization().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockClusterReplicaScan(mock, "")
+		testhelpers.MockClusterReplicaUtilizationScan(mock, "")
+
+		if err := clusterUtilizationRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal(1, d.Get("replicas.#"))
+		r.Equal("replica", d.Get("replicas.0.name"))
+		r.Equal(97.25, d.Get("replicas.0.memory_percent"))
+		r.Equal("not-ready", d.Get("replicas.0.status"))
+		r.Equal("oom-killed", d.Get("replicas.0.reason"))
+	})
+}
+
+func TestClusterUtilizationDatasourceOtherCluster(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{"cluster_name": "other"}
+	d := schema.TestResourceDataRaw(t, ClusterUtilization().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockClusterReplicaScan(mock, "")
+		testhelpers.MockClusterReplicaUtilizationScan(mock, "")
+
+		if err := clusterUtilizationRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal(0, d.Get("replicas.#"))
+	})
+}
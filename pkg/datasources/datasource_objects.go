@@ -0,0 +1,168 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func Objects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: objectsRead,
+		Description: "Searches the objects of every database and schema, such as sources, views, materialized views, tables, sinks and indexes.",
+		Schema: map[string]*schema.Schema{
+			"types": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(materialize.ObjectTypes, false)},
+				Optional:    true,
+				Description: "Limit objects to the given types, such as `source` or `materialized-view`",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit objects to a specific database",
+			},
+			"schema_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit objects to schemas with this name",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Limit objects to names matching the regular expression",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"owner_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit objects to those owned by the role",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limit objects to those maintained by the cluster",
+			},
+			"comment_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Limit objects to comments matching the regular expression",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"include_system_objects": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include the objects of the system catalog",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The objects matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"qualified_sql_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": RegionSchema(),
+		},
+	}
+}
+
+func objectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	var diags diag.Diagnostics
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s := materialize.ObjectSearch{
+		Types:                materialize.GetSliceValueString(d.Get("types").([]interface{})),
+		DatabaseName:         databaseName,
+		SchemaName:           schemaName,
+		NameRegex:            d.Get("name_regex").(string),
+		OwnerName:            d.Get("owner_name").(string),
+		ClusterName:          d.Get("cluster_name").(string),
+		CommentRegex:         d.Get("comment_regex").(string),
+		IncludeSystemObjects: d.Get("include_system_objects").(bool),
+	}
+	dataSource, err := materialize.ListObjects(metaDb, s)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	objectFormats := []map[string]interface{}{}
+	for _, p := range dataSource {
+		objectMap := map[string]interface{}{}
+
+		objectMap["id"] = p.ObjectId.String
+		objectMap["name"] = p.ObjectName.String
+		objectMap["schema_name"] = p.SchemaName.String
+		objectMap["database_name"] = p.DatabaseName.String
+		objectMap["qualified_sql_name"] = p.QualifiedName()
+		objectMap["type"] = p.ObjectType.String
+		objectMap["owner_name"] = p.OwnerName.String
+		objectMap["cluster_name"] = p.ClusterName.String
+		objectMap["comment"] = p.Comment.String
+		objectMap["created_at"] = p.CreatedAt.String
+
+		objectFormats = append(objectFormats, objectMap)
+	}
+
+	if err := d.Set("objects", objectFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	SetId(string(region), "objects", databaseName, schemaName, d)
+
+	return diags
+}
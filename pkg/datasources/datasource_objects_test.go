@@ -0,0 +1,43 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectsDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"types":      []interface{}{"materialized-view", "view"},
+		"name_regex": "^orders",
+		"owner_name": "owner",
+	}
+	d := schema.TestResourceDataRaw(t, Objects().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_objects.id LIKE 'u%' AND mz_objects.name ~ '\^orders' AND mz_objects.type IN \('materialized-view', 'view'\) AND mz_roles.name = 'owner'`
+		testhelpers.MockObjectSearchScan(mock, p)
+
+		if err := objectsRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal(2, d.Get("objects.#"))
+		r.Equal("u1", d.Get("objects.0.id"))
+		r.Equal(`"database"."schema"."orders"`, d.Get("objects.0.qualified_sql_name"))
+		r.Equal("materialized-view", d.Get("objects.0.type"))
+		r.Equal("cluster", d.Get("objects.0.cluster_name"))
+		r.Equal("orders by customer", d.Get("objects.0.comment"))
+		r.Equal("2026-01-01T00:00:00Z", d.Get("objects.0.created_at"))
+		r.Equal("", d.Get("objects.1.cluster_name"))
+	})
+}
@@ -68,7 +68,7 @@ func RegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	for _, provider := range providers {
 		host, err := client.GetHost(ctx, provider.ID)
 		if err != nil {
-			if strings.Contains(err.Error(), "non-200 status code: 204") {
+			if strings.Contains(err.Error(), "is not enabled") {
 				log.Printf("[WARN] No host available for region %s, skipping", provider.ID)
 				continue
 			}
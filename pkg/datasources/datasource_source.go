@@ -67,6 +67,27 @@ func Source() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"columns": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The columns of the source, including any columns added by the source options such as the upsert value decoding `error` column.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"nullable": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -90,6 +111,20 @@ func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 
+	sourceColumns, err := materialize.ListSourceColumns(metaDb, schemaName, databaseName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	columnFormats := map[string][]map[string]interface{}{}
+	for _, c := range sourceColumns {
+		columnMap := map[string]interface{}{}
+		columnMap["name"] = c.Name.String
+		columnMap["type"] = c.Type.String
+		columnMap["nullable"] = c.Nullable.Bool
+		columnFormats[c.Id.String] = append(columnFormats[c.Id.String], columnMap)
+	}
+
 	sourceFormats := []map[string]interface{}{}
 	for _, p := range dataSource {
 		sourceMap := map[string]interface{}{}
@@ -104,6 +139,12 @@ func sourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		sourceMap["connection_name"] = p.ConnectionName.String
 		sourceMap["cluster_name"] = p.ClusterName.String
 
+		columns := columnFormats[p.SourceId.String]
+		if columns == nil {
+			columns = []map[string]interface{}{}
+		}
+		sourceMap["columns"] = columns
+
 		sourceFormats = append(sourceFormats, sourceMap)
 	}
 
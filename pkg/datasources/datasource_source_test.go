@@ -26,9 +26,14 @@ func TestSourceDatasource(t *testing.T) {
 		p := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
 		testhelpers.MockSourceScan(mock, p)
 
+		// Query Columns of all sources at once
+		testhelpers.MockSourceColumnScan(mock, p)
+
 		if err := sourceRead(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
+
+		r.Equal("error", d.Get("sources.0.columns.1.name"))
 	})
 
 }
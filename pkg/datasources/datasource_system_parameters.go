@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SystemParameters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: systemParametersRead,
+		Schema: map[string]*schema.Schema{
+			"parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The configuration parameters visible in the region and their current value",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"region": RegionSchema(),
+		},
+	}
+}
+
+func systemParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dataSource, err := materialize.ListSystemParameters(metaDb)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parameterFormats := []map[string]interface{}{}
+	for _, p := range dataSource {
+		parameterMap := map[string]interface{}{}
+
+		parameterMap["name"] = p.Name
+		parameterMap["value"] = p.Setting
+		parameterMap["description"] = p.Description
+
+		parameterFormats = append(parameterFormats, parameterMap)
+	}
+
+	if err := d.Set("parameters", parameterFormats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), "system_parameters"))
+	return diags
+}
@@ -0,0 +1,33 @@
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemParametersDatasource(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{}
+	d := schema.TestResourceDataRaw(t, SystemParameters().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockSystemParametersScan(mock)
+
+		if err := systemParametersRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal(2, d.Get("parameters.#"))
+		r.Equal("max_clusters", d.Get("parameters.0.name"))
+		r.Equal("100", d.Get("parameters.0.value"))
+	})
+}
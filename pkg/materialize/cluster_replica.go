@@ -182,3 +182,55 @@ func ListClusterReplicas(conn *sqlx.DB) ([]ClusterReplicaParams, error) {
 
 	return c, nil
 }
+
+type ClusterReplicaUtilizationParams struct {
+	ReplicaId     sql.NullString  `db:"id"`
+	CpuPercent    sql.NullFloat64 `db:"cpu_percent"`
+	MemoryPercent sql.NullFloat64 `db:"memory_percent"`
+	DiskPercent   sql.NullFloat64 `db:"disk_percent"`
+	Status        sql.NullString  `db:"status"`
+	Reason        sql.NullString  `db:"reason"`
+}
+
+// The utilization and status of a replica are those of its busiest and least
+// healthy process.
+var clusterReplicaUtilizationQuery = NewBaseQuery(`
+	SELECT
+		mz_cluster_replicas.id,
+		utilization.cpu_percent,
+		utilization.memory_percent,
+		utilization.disk_percent,
+		statuses.status,
+		statuses.reason
+	FROM mz_cluster_replicas
+	LEFT JOIN (
+		SELECT
+			replica_id,
+			max(cpu_percent) AS cpu_percent,
+			max(memory_percent) AS memory_percent,
+			max(disk_percent) AS disk_percent
+		FROM mz_internal.mz_cluster_replica_utilization
+		GROUP BY replica_id
+	) utilization
+		ON mz_cluster_replicas.id = utilization.replica_id
+	LEFT JOIN (
+		SELECT
+			replica_id,
+			CASE WHEN bool_and(status = 'ready') THEN 'ready' ELSE 'not-ready' END AS status,
+			max(reason) AS reason
+		FROM mz_internal.mz_cluster_replica_statuses
+		GROUP BY replica_id
+	) statuses
+		ON mz_cluster_replicas.id = statuses.replica_id`)
+
+func ListClusterReplicaUtilization(conn *sqlx.DB) ([]ClusterReplicaUtilizationParams, error) {
+	p := map[string]string{}
+	q := clusterReplicaUtilizationQuery.QueryPredicate(p)
+
+	var c []ClusterReplicaUtilizationParams
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
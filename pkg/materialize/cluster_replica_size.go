@@ -0,0 +1,41 @@
+package materialize
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ClusterReplicaSizeParams struct {
+	Size           sql.NullString  `db:"size"`
+	Processes      sql.NullInt64   `db:"processes"`
+	Workers        sql.NullInt64   `db:"workers"`
+	CpuNanoCores   sql.NullInt64   `db:"cpu_nano_cores"`
+	MemoryBytes    sql.NullInt64   `db:"memory_bytes"`
+	DiskBytes      sql.NullInt64   `db:"disk_bytes"`
+	CreditsPerHour sql.NullFloat64 `db:"credits_per_hour"`
+}
+
+var clusterReplicaSizeQuery = NewBaseQuery(`
+	SELECT
+		mz_cluster_replica_sizes.size,
+		mz_cluster_replica_sizes.processes::int8 AS processes,
+		mz_cluster_replica_sizes.workers::int8 AS workers,
+		mz_cluster_replica_sizes.cpu_nano_cores::int8 AS cpu_nano_cores,
+		mz_cluster_replica_sizes.memory_bytes::int8 AS memory_bytes,
+		mz_cluster_replica_sizes.disk_bytes::int8 AS disk_bytes,
+		mz_cluster_replica_sizes.credits_per_hour::float8 AS credits_per_hour
+	FROM mz_internal.mz_cluster_replica_sizes`).Order("credits_per_hour, size")
+
+// ListClusterReplicaSizes returns the replica sizes available in the region,
+// from the smallest to the largest.
+func ListClusterReplicaSizes(conn *sqlx.DB) ([]ClusterReplicaSizeParams, error) {
+	q := clusterReplicaSizeQuery.QueryPredicate(map[string]string{})
+
+	var c []ClusterReplicaSizeParams
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,24 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestListClusterReplicaSizes(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockClusterReplicaSizeScan(mock)
+
+		sizes, err := ListClusterReplicaSizes(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(sizes) != 2 || sizes[0].Size.String != "25cc" || sizes[1].CreditsPerHour.Float64 != 1 {
+			t.Fatalf("unexpected sizes %v", sizes)
+		}
+	})
+}
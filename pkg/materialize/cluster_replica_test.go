@@ -39,3 +39,18 @@ func TestClusterReplicaDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestListClusterReplicaUtilization(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockClusterReplicaUtilizationScan(mock, "")
+
+		replicas, err := ListClusterReplicaUtilization(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(replicas) != 1 || replicas[0].MemoryPercent.Float64 != 97.25 || replicas[0].Reason.String != "oom-killed" {
+			t.Fatalf("unexpected utilization %v", replicas)
+		}
+	})
+}
@@ -46,43 +46,31 @@ func ListTableColumns(conn *sqlx.DB, objectId string) ([]TableColumnParams, erro
 	return c, nil
 }
 
-type IndexColumnParams struct {
-	Id            sql.NullString `db:"id"`
-	Name          sql.NullString `db:"name"`
-	Position      sql.NullString `db:"position"`
-	Nullable      sql.NullBool   `db:"nullable"`
-	Type          sql.NullString `db:"type"`
-	Default       sql.NullString `db:"default"`
-	IndexedColumn sql.NullBool   `db:"indexed_column"`
-	IndexName     sql.NullString `db:"index_name"`
-	IndexId       sql.NullString `db:"index_id"`
-}
-
-var indexColumnQuery = NewBaseQuery(`
+var sourceColumnQuery = NewBaseQuery(`
 	SELECT
 		mz_columns.id,
 		mz_columns.name,
 		mz_columns.position,
 		mz_columns.nullable,
-		mz_columns.type,
-		mz_columns.default,
-		CASE WHEN mz_index_columns.index_id IS NOT NULL THEN true ELSE false END AS indexed_column,
-		mz_indexes.name AS index_name,
-		mz_indexes.id AS index_id
+		mz_columns.type
 	FROM mz_columns
-	LEFT JOIN mz_indexes
-		ON mz_columns.id = mz_indexes.on_id
-	LEFT JOIN mz_index_columns
-		ON mz_index_columns.index_id = mz_indexes.id
-		AND mz_index_columns.index_position = mz_columns.position`).Order("mz_columns.position")
+	JOIN mz_sources
+		ON mz_columns.id = mz_sources.id
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`).Order("mz_columns.id, mz_columns.position")
 
-func ListIndexColumns(conn *sqlx.DB, indexiId string) ([]IndexColumnParams, error) {
+// ListSourceColumns returns the columns of every source in the schema and
+// database in one query, ordered by source id and position.
+func ListSourceColumns(conn *sqlx.DB, schemaName, databaseName string) ([]TableColumnParams, error) {
 	p := map[string]string{
-		"mz_indexes.id": indexiId,
+		"mz_schemas.name":   schemaName,
+		"mz_databases.name": databaseName,
 	}
-	q := indexColumnQuery.QueryPredicate(p)
+	q := sourceColumnQuery.QueryPredicate(p)
 
-	var c []IndexColumnParams
+	var c []TableColumnParams
 	if err := conn.Select(&c, q); err != nil {
 		return c, err
 	}
@@ -1,7 +1,9 @@
 package materialize
 
 import (
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -30,3 +32,71 @@ func (b *CommentBuilder) Column(column, comment string) error {
 	q := fmt.Sprintf(`COMMENT ON COLUMN %s.%s IS %s;`, b.object.QualifiedName(), col, c)
 	return b.ddl.exec(q)
 }
+
+// Removes the comment on the object
+func (b *CommentBuilder) RemoveObject() error {
+	q := fmt.Sprintf(`COMMENT ON %s %s IS NULL;`, b.object.ObjectType, b.object.QualifiedName())
+	return b.ddl.exec(q)
+}
+
+// Removes the comment on a column of the object
+func (b *CommentBuilder) RemoveColumn(column string) error {
+	col := QuoteIdentifier(column)
+	q := fmt.Sprintf(`COMMENT ON COLUMN %s.%s IS NULL;`, b.object.QualifiedName(), col)
+	return b.ddl.exec(q)
+}
+
+// CommentObjectType returns the object type of an object as reported in
+// mz_comments, such as `materialized-view` for `MATERIALIZED VIEW`.
+func CommentObjectType(objectType string) string {
+	return strings.ReplaceAll(strings.ToLower(objectType), " ", "-")
+}
+
+type CommentParams struct {
+	ObjectId    sql.NullString `db:"id"`
+	ObjectType  sql.NullString `db:"object_type"`
+	ObjectSubId sql.NullInt64  `db:"object_sub_id"`
+	Comment     sql.NullString `db:"comment"`
+}
+
+var objectCommentQuery = NewBaseQuery(`
+	SELECT
+		mz_comments.id,
+		mz_comments.object_type,
+		mz_comments.object_sub_id,
+		mz_comments.comment
+	FROM mz_internal.mz_comments`).
+	CustomPredicate([]string{"mz_comments.object_sub_id IS NULL"})
+
+var columnCommentQuery = NewBaseQuery(`
+	SELECT
+		mz_comments.id,
+		mz_comments.object_type,
+		mz_comments.object_sub_id,
+		mz_comments.comment
+	FROM mz_internal.mz_comments
+	JOIN mz_columns
+		ON mz_comments.id = mz_columns.id
+		AND mz_comments.object_sub_id = mz_columns.position`)
+
+// ScanComment returns the comment on an object, or on a column of the object
+// when column is set.
+func ScanComment(conn *sqlx.DB, objectType, objectId, column string) (CommentParams, error) {
+	p := map[string]string{
+		"mz_comments.id":          objectId,
+		"mz_comments.object_type": CommentObjectType(objectType),
+	}
+
+	q := objectCommentQuery.QueryPredicate(p)
+	if column != "" {
+		p["mz_columns.name"] = column
+		q = columnCommentQuery.QueryPredicate(p)
+	}
+
+	var c CommentParams
+	if err := conn.Get(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
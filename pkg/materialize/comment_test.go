@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCommentObject(t *testing.T) {
@@ -31,3 +32,48 @@ func TestCommentColumn(t *testing.T) {
 		}
 	})
 }
+
+func TestCommentRemoveObject(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`COMMENT ON CLUSTER REPLICA "cluster"."replica" IS NULL;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{ObjectType: "CLUSTER REPLICA", Name: "replica", ClusterName: "cluster"}
+		if err := NewCommentBuilder(db, o).RemoveObject(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestCommentRemoveColumn(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`COMMENT ON COLUMN "database"."schema"."view"."column" IS NULL;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{ObjectType: "VIEW", Name: "view", DatabaseName: "database", SchemaName: "schema"}
+		if err := NewCommentBuilder(db, o).RemoveColumn("column"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestScanComment(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockCommentScan(mock, `WHERE mz_comments.id = 'u1' AND mz_comments.object_sub_id IS NULL AND mz_comments.object_type = 'cluster'`)
+
+		c, err := ScanComment(db, "CLUSTER", "u1", "")
+		r.NoError(err)
+		r.Equal("comment", c.Comment.String)
+	})
+}
+
+func TestScanColumnComment(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockColumnCommentScan(mock, `WHERE mz_columns.name = 'column' AND mz_comments.id = 'u1' AND mz_comments.object_type = 'materialized-view'`)
+
+		c, err := ScanComment(db, "MATERIALIZED VIEW", "u1", "column")
+		r.NoError(err)
+		r.Equal(int64(1), c.ObjectSubId.Int64)
+		r.Equal("column comment", c.Comment.String)
+	})
+}
@@ -0,0 +1,217 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ConnectionAwsBuilder struct {
+	Connection
+	awsEndpoint              string
+	awsRegion                string
+	awsAccessKeyId           ValueSecretStruct
+	awsSecretAccessKey       IdentifierSchemaStruct
+	awsSessionToken          ValueSecretStruct
+	awsAssumeRoleArn         string
+	awsAssumeRoleSessionName string
+	validate                 bool
+}
+
+func NewConnectionAwsBuilder(conn *sqlx.DB, obj MaterializeObject) *ConnectionAwsBuilder {
+	b := Builder{conn, BaseConnection}
+	return &ConnectionAwsBuilder{
+		Connection: Connection{b, obj.Name, obj.SchemaName, obj.DatabaseName},
+	}
+}
+
+func (b *ConnectionAwsBuilder) Endpoint(endpoint string) *ConnectionAwsBuilder {
+	b.awsEndpoint = endpoint
+	return b
+}
+
+func (b *ConnectionAwsBuilder) AwsRegion(awsRegion string) *ConnectionAwsBuilder {
+	b.awsRegion = awsRegion
+	return b
+}
+
+func (b *ConnectionAwsBuilder) AccessKeyId(accessKeyId ValueSecretStruct) *ConnectionAwsBuilder {
+	b.awsAccessKeyId = accessKeyId
+	return b
+}
+
+func (b *ConnectionAwsBuilder) SecretAccessKey(secretAccessKey IdentifierSchemaStruct) *ConnectionAwsBuilder {
+	b.awsSecretAccessKey = secretAccessKey
+	return b
+}
+
+func (b *ConnectionAwsBuilder) SessionToken(sessionToken ValueSecretStruct) *ConnectionAwsBuilder {
+	b.awsSessionToken = sessionToken
+	return b
+}
+
+func (b *ConnectionAwsBuilder) AssumeRoleArn(assumeRoleArn string) *ConnectionAwsBuilder {
+	b.awsAssumeRoleArn = assumeRoleArn
+	return b
+}
+
+func (b *ConnectionAwsBuilder) AssumeRoleSessionName(assumeRoleSessionName string) *ConnectionAwsBuilder {
+	b.awsAssumeRoleSessionName = assumeRoleSessionName
+	return b
+}
+
+func (b *ConnectionAwsBuilder) Validate(validate bool) *ConnectionAwsBuilder {
+	b.validate = validate
+	return b
+}
+
+func (b *ConnectionAwsBuilder) Create() error {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE CONNECTION %s TO AWS (`, b.QualifiedName()))
+
+	var options = []string{}
+	if b.awsEndpoint != "" {
+		options = append(options, fmt.Sprintf(`ENDPOINT = %s`, QuoteString(b.awsEndpoint)))
+	}
+	if b.awsRegion != "" {
+		options = append(options, fmt.Sprintf(`REGION = %s`, QuoteString(b.awsRegion)))
+	}
+	if b.awsAccessKeyId.Text != "" {
+		options = append(options, fmt.Sprintf(`ACCESS KEY ID = %s`, QuoteString(b.awsAccessKeyId.Text)))
+	}
+	if b.awsAccessKeyId.Secret.Name != "" {
+		options = append(options, fmt.Sprintf(`ACCESS KEY ID = SECRET %s`, b.awsAccessKeyId.Secret.QualifiedName()))
+	}
+	if b.awsSecretAccessKey.Name != "" {
+		options = append(options, fmt.Sprintf(`SECRET ACCESS KEY = SECRET %s`, b.awsSecretAccessKey.QualifiedName()))
+	}
+	if b.awsSessionToken.Text != "" {
+		options = append(options, fmt.Sprintf(`SESSION TOKEN = %s`, QuoteString(b.awsSessionToken.Text)))
+	}
+	if b.awsSessionToken.Secret.Name != "" {
+		options = append(options, fmt.Sprintf(`SESSION TOKEN = SECRET %s`, b.awsSessionToken.Secret.QualifiedName()))
+	}
+	if b.awsAssumeRoleArn != "" {
+		options = append(options, fmt.Sprintf(`ASSUME ROLE ARN = %s`, QuoteString(b.awsAssumeRoleArn)))
+	}
+	if b.awsAssumeRoleSessionName != "" {
+		options = append(options, fmt.Sprintf(`ASSUME ROLE SESSION NAME = %s`, QuoteString(b.awsAssumeRoleSessionName)))
+	}
+
+	q.WriteString(strings.Join(options, ", "))
+	q.WriteString(`)`)
+
+	// AWS connections using role assumption cannot be validated until the
+	// trust policy that references the external id is in place
+	if b.validate {
+		q.WriteString(` WITH (VALIDATE = true)`)
+	}
+
+	q.WriteString(`;`)
+	return b.ddl.exec(q.String())
+}
+
+type ConnectionAwsParams struct {
+	ConnectionId          sql.NullString `db:"id"`
+	ConnectionName        sql.NullString `db:"connection_name"`
+	SchemaName            sql.NullString `db:"schema_name"`
+	DatabaseName          sql.NullString `db:"database_name"`
+	Endpoint              sql.NullString `db:"endpoint"`
+	AwsRegion             sql.NullString `db:"region"`
+	AccessKeyId           sql.NullString `db:"access_key_id"`
+	AssumeRoleArn         sql.NullString `db:"assume_role_arn"`
+	AssumeRoleSessionName sql.NullString `db:"assume_role_session_name"`
+	Principal             sql.NullString `db:"principal"`
+	ExternalId            sql.NullString `db:"external_id"`
+	ExampleTrustPolicy    sql.NullString `db:"example_trust_policy"`
+	Comment               sql.NullString `db:"comment"`
+	OwnerName             sql.NullString `db:"owner_name"`
+}
+
+var connectionAwsQuery = NewBaseQuery(`
+	SELECT
+		mz_connections.id,
+		mz_connections.name AS connection_name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_aws_connections.endpoint,
+		mz_aws_connections.region,
+		mz_aws_connections.access_key_id,
+		mz_aws_connections.assume_role_arn,
+		mz_aws_connections.assume_role_session_name,
+		mz_aws_connections.principal,
+		mz_aws_connections.external_id,
+		mz_aws_connections.example_trust_policy,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name
+	FROM mz_connections
+	JOIN mz_schemas
+		ON mz_connections.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_internal.mz_aws_connections
+		ON mz_connections.id = mz_aws_connections.id
+	JOIN mz_roles
+		ON mz_connections.owner_id = mz_roles.id
+	LEFT JOIN (
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'connection'
+	) comments
+		ON mz_connections.id = comments.id`)
+
+func ScanConnectionAws(conn *sqlx.DB, id string) (ConnectionAwsParams, error) {
+	q := connectionAwsQuery.QueryPredicate(map[string]string{"mz_connections.id": id})
+
+	var c ConnectionAwsParams
+	if err := conn.Get(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type ConnectionAwsDefinition struct {
+	Endpoint              string
+	Region                string
+	AccessKeyId           ValueSecretStruct
+	SecretAccessKey       IdentifierSchemaStruct
+	SessionToken          ValueSecretStruct
+	AssumeRoleArn         string
+	AssumeRoleSessionName string
+}
+
+func ParseConnectionAws(createSql string) (ConnectionAwsDefinition, error) {
+	var c ConnectionAwsDefinition
+
+	options, err := parseConnectionOptions(createSql, "AWS")
+	if err != nil {
+		return c, err
+	}
+
+	for name, o := range options {
+		switch name {
+		case "ENDPOINT":
+			c.Endpoint = o.optionValue()
+		case "REGION":
+			c.Region = o.optionValue()
+		case "ACCESS KEY ID":
+			c.AccessKeyId, err = o.optionValueSecret()
+		case "SECRET ACCESS KEY":
+			c.SecretAccessKey, err = o.optionIdentifier()
+		case "SESSION TOKEN":
+			c.SessionToken, err = o.optionValueSecret()
+		case "ASSUME ROLE ARN":
+			c.AssumeRoleArn = o.optionValue()
+		case "ASSUME ROLE SESSION NAME":
+			c.AssumeRoleSessionName = o.optionValue()
+		}
+		if err != nil {
+			return c, err
+		}
+	}
+
+	return c, nil
+}
@@ -94,3 +94,32 @@ func ScanConnectionAwsPrivatelink(conn *sqlx.DB, id string) (ConnectionAwsPrivat
 
 	return c, nil
 }
+
+type ConnectionAwsPrivatelinkDefinition struct {
+	ServiceName       string
+	AvailabilityZones []string
+}
+
+func ParseConnectionAwsPrivatelink(createSql string) (ConnectionAwsPrivatelinkDefinition, error) {
+	var c ConnectionAwsPrivatelinkDefinition
+
+	options, err := parseConnectionOptions(createSql, "AWS", "PRIVATELINK")
+	if err != nil {
+		return c, err
+	}
+
+	for name, o := range options {
+		switch name {
+		case "SERVICE NAME":
+			c.ServiceName = o.optionValue()
+		case "AVAILABILITY ZONES":
+			for _, t := range o.Value {
+				if t.kind == sqlString {
+					c.AvailabilityZones = append(c.AvailabilityZones, t.value)
+				}
+			}
+		}
+	}
+
+	return c, nil
+}
@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConnectionAwsPrivatelinkCreate(t *testing.T) {
@@ -24,3 +25,21 @@ func TestConnectionAwsPrivatelinkCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestConnectionAwsPrivatelinkRoundTrip(t *testing.T) {
+	r := require.New(t)
+	o := MaterializeObject{Name: "privatelink_conn", SchemaName: "schema", DatabaseName: "database"}
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewConnectionAwsPrivatelinkBuilder(db, o).
+			PrivateLinkServiceName("com.amazonaws.us-east-1.materialize.example").
+			PrivateLinkAvailabilityZones([]string{"use1-az1", "use1-az2"}).
+			Create()
+	})
+
+	c, err := ParseConnectionAwsPrivatelink(s)
+	r.NoError(err)
+	r.Equal(ConnectionAwsPrivatelinkDefinition{
+		ServiceName:       "com.amazonaws.us-east-1.materialize.example",
+		AvailabilityZones: []string{"use1-az1", "use1-az2"},
+	}, c)
+}
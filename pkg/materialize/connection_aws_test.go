@@ -0,0 +1,72 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var connAws = MaterializeObject{Name: "aws_conn", SchemaName: "schema", DatabaseName: "database"}
+
+func TestConnectionAwsAssumeRoleCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."aws_conn" TO AWS \(REGION = 'us-east-1', ASSUME ROLE ARN = 'arn:aws:iam::123456789012:role/Materialize', ASSUME ROLE SESSION NAME = 'materialize'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewConnectionAwsBuilder(db, connAws)
+		b.AwsRegion("us-east-1")
+		b.AssumeRoleArn("arn:aws:iam::123456789012:role/Materialize")
+		b.AssumeRoleSessionName("materialize")
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionAwsCredentialsCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."aws_conn" TO AWS \(ENDPOINT = 'http://localhost:4566', REGION = 'us-east-1', ACCESS KEY ID = 'key', SECRET ACCESS KEY = SECRET "database"."schema"."secret_key", SESSION TOKEN = SECRET "database"."schema"."token"\) WITH \(VALIDATE = true\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewConnectionAwsBuilder(db, connAws)
+		b.Endpoint("http://localhost:4566")
+		b.AwsRegion("us-east-1")
+		b.AccessKeyId(ValueSecretStruct{Text: "key"})
+		b.SecretAccessKey(IdentifierSchemaStruct{Name: "secret_key", DatabaseName: "database", SchemaName: "schema"})
+		b.SessionToken(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "token", DatabaseName: "database", SchemaName: "schema"}})
+		b.Validate(true)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionAwsRoundTrip(t *testing.T) {
+	r := require.New(t)
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewConnectionAwsBuilder(db, connAws).
+			Endpoint("https://sts.amazonaws.com").
+			AwsRegion("us-east-1").
+			AccessKeyId(ValueSecretStruct{Text: "access_key"}).
+			SecretAccessKey(IdentifierSchemaStruct{Name: "secret_key", SchemaName: "schema", DatabaseName: "database"}).
+			SessionToken(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "token", SchemaName: "schema", DatabaseName: "database"}}).
+			Create()
+	})
+
+	c, err := ParseConnectionAws(s)
+	r.NoError(err)
+	r.Equal(ConnectionAwsDefinition{
+		Endpoint:        "https://sts.amazonaws.com",
+		Region:          "us-east-1",
+		AccessKeyId:     ValueSecretStruct{Text: "access_key"},
+		SecretAccessKey: IdentifierSchemaStruct{Name: "secret_key", SchemaName: "schema", DatabaseName: "database"},
+		SessionToken:    ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "token", SchemaName: "schema", DatabaseName: "database"}},
+	}, c)
+}
@@ -117,3 +117,49 @@ func (b *ConnectionConfluentSchemaRegistryBuilder) Create() error {
 	q.WriteString(`;`)
 	return b.ddl.exec(q.String())
 }
+
+type ConnectionConfluentSchemaRegistryDefinition struct {
+	Url            string
+	SSLCa          ValueSecretStruct
+	SSLCert        ValueSecretStruct
+	SSLKey         IdentifierSchemaStruct
+	Username       ValueSecretStruct
+	Password       IdentifierSchemaStruct
+	SSHTunnel      IdentifierSchemaStruct
+	AWSPrivateLink IdentifierSchemaStruct
+}
+
+func ParseConnectionConfluentSchemaRegistry(createSql string) (ConnectionConfluentSchemaRegistryDefinition, error) {
+	var c ConnectionConfluentSchemaRegistryDefinition
+
+	options, err := parseConnectionOptions(createSql, "CONFLUENT", "SCHEMA", "REGISTRY")
+	if err != nil {
+		return c, err
+	}
+
+	for name, o := range options {
+		switch name {
+		case "URL":
+			c.Url = o.optionValue()
+		case "SSL CERTIFICATE AUTHORITY":
+			c.SSLCa, err = o.optionValueSecret()
+		case "SSL CERTIFICATE":
+			c.SSLCert, err = o.optionValueSecret()
+		case "SSL KEY":
+			c.SSLKey, err = o.optionIdentifier()
+		case "USERNAME":
+			c.Username, err = o.optionValueSecret()
+		case "PASSWORD":
+			c.Password, err = o.optionIdentifier()
+		case "SSH TUNNEL":
+			c.SSHTunnel, err = o.optionIdentifier()
+		case "AWS PRIVATELINK":
+			c.AWSPrivateLink, err = o.optionIdentifier()
+		}
+		if err != nil {
+			return c, err
+		}
+	}
+
+	return c, nil
+}
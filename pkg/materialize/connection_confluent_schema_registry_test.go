@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 var connConfluentSchema = MaterializeObject{Name: "csr_conn", SchemaName: "schema", DatabaseName: "database"}
@@ -43,3 +44,24 @@ func TestConnectionConfluentSchemaRegistryUsernameSecretCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestConnectionConfluentSchemaRegistryRoundTrip(t *testing.T) {
+	r := require.New(t)
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewConnectionConfluentSchemaRegistryBuilder(db, connConfluentSchema).
+			ConfluentSchemaRegistryUrl("http://localhost:8081").
+			ConfluentSchemaRegistryUsername(ValueSecretStruct{Text: "user"}).
+			ConfluentSchemaRegistryPassword(IdentifierSchemaStruct{Name: "password", SchemaName: "schema", DatabaseName: "database"}).
+			ConfluentSchemaRegistryAWSPrivateLink(IdentifierSchemaStruct{Name: "privatelink_conn", SchemaName: "schema", DatabaseName: "database"}).
+			Create()
+	})
+
+	c, err := ParseConnectionConfluentSchemaRegistry(s)
+	r.NoError(err)
+	r.Equal(ConnectionConfluentSchemaRegistryDefinition{
+		Url:            "http://localhost:8081",
+		Username:       ValueSecretStruct{Text: "user"},
+		Password:       IdentifierSchemaStruct{Name: "password", SchemaName: "schema", DatabaseName: "database"},
+		AWSPrivateLink: IdentifierSchemaStruct{Name: "privatelink_conn", SchemaName: "schema", DatabaseName: "database"},
+	}, c)
+}
@@ -50,6 +50,7 @@ type ConnectionKafkaBuilder struct {
 	kafkaSASLUsername     ValueSecretStruct
 	kafkaSASLPassword     IdentifierSchemaStruct
 	kafkaSSHTunnel        IdentifierSchemaStruct
+	kafkaAwsConnection    IdentifierSchemaStruct
 	validate              bool
 }
 
@@ -110,6 +111,11 @@ func (b *ConnectionKafkaBuilder) KafkaSSHTunnel(kafkaSSHTunnel IdentifierSchemaS
 	return b
 }
 
+func (b *ConnectionKafkaBuilder) KafkaAwsConnection(kafkaAwsConnection IdentifierSchemaStruct) *ConnectionKafkaBuilder {
+	b.kafkaAwsConnection = kafkaAwsConnection
+	return b
+}
+
 func (b *ConnectionKafkaBuilder) Validate(validate bool) *ConnectionKafkaBuilder {
 	b.validate = validate
 	return b
@@ -205,6 +211,9 @@ func (b *ConnectionKafkaBuilder) Create() error {
 	if b.kafkaSASLPassword.Name != "" {
 		q.WriteString(fmt.Sprintf(`, SASL PASSWORD = SECRET %s`, b.kafkaSASLPassword.QualifiedName()))
 	}
+	if b.kafkaAwsConnection.Name != "" {
+		q.WriteString(fmt.Sprintf(`, AWS CONNECTION = %s`, b.kafkaAwsConnection.QualifiedName()))
+	}
 
 	q.WriteString(`)`)
 
@@ -214,3 +223,110 @@ func (b *ConnectionKafkaBuilder) Create() error {
 
 	return b.ddl.exec(q.String())
 }
+
+type ConnectionKafkaDefinition struct {
+	KafkaBrokers     []KafkaBroker
+	SecurityProtocol string
+	ProgressTopic    string
+	SSLCa            ValueSecretStruct
+	SSLCert          ValueSecretStruct
+	SSLKey           IdentifierSchemaStruct
+	SASLMechanisms   string
+	SASLUsername     ValueSecretStruct
+	SASLPassword     IdentifierSchemaStruct
+	SSHTunnel        IdentifierSchemaStruct
+	AwsConnection    IdentifierSchemaStruct
+}
+
+func ParseConnectionKafka(createSql string) (ConnectionKafkaDefinition, error) {
+	var c ConnectionKafkaDefinition
+
+	options, err := parseConnectionOptions(createSql, "KAFKA")
+	if err != nil {
+		return c, err
+	}
+
+	for name, o := range options {
+		switch name {
+		case "BROKER", "BROKERS":
+			c.KafkaBrokers, err = parseKafkaBrokers(o)
+		case "SECURITY PROTOCOL":
+			c.SecurityProtocol = strings.ToUpper(o.optionValue())
+		case "PROGRESS TOPIC":
+			c.ProgressTopic = o.optionValue()
+		case "SSL CERTIFICATE AUTHORITY":
+			c.SSLCa, err = o.optionValueSecret()
+		case "SSL CERTIFICATE":
+			c.SSLCert, err = o.optionValueSecret()
+		case "SSL KEY":
+			c.SSLKey, err = o.optionIdentifier()
+		case "SASL MECHANISMS":
+			c.SASLMechanisms = strings.ToUpper(o.optionValue())
+		case "SASL USERNAME":
+			c.SASLUsername, err = o.optionValueSecret()
+		case "SASL PASSWORD":
+			c.SASLPassword, err = o.optionIdentifier()
+		case "SSH TUNNEL":
+			c.SSHTunnel, err = o.optionIdentifier()
+		case "AWS CONNECTION":
+			c.AwsConnection, err = o.optionIdentifier()
+		}
+		if err != nil {
+			return c, err
+		}
+	}
+
+	return c, nil
+}
+
+// parseKafkaBrokers reads either a single `BROKER` or a parenthesized list
+// of `BROKERS`, each with an optional SSH tunnel or AWS PrivateLink.
+func parseKafkaBrokers(o sqlOption) ([]KafkaBroker, error) {
+	var brokers []KafkaBroker
+
+	p := o.parser()
+	list := p.acceptSymbol("(")
+	for !p.done() && !p.acceptSymbol(")") {
+		var b KafkaBroker
+		var err error
+		if b.Broker, err = p.parseString(); err != nil {
+			return nil, err
+		}
+
+		if p.acceptKeyword("USING", "SSH", "TUNNEL") {
+			if b.SSHTunnel, err = p.parseQualifiedName(); err != nil {
+				return nil, err
+			}
+		} else if p.acceptKeyword("USING", "AWS", "PRIVATELINK") {
+			if b.PrivateLinkConnection, err = p.parseQualifiedName(); err != nil {
+				return nil, err
+			}
+			if p.peekSymbol("(") {
+				options, err := p.parseOptionList()
+				if err != nil {
+					return nil, err
+				}
+				for _, o := range options {
+					switch o.Name {
+					case "PORT":
+						if v, err := o.optionInts(); err != nil {
+							return nil, err
+						} else if len(v) > 0 {
+							b.TargetGroupPort = v[0]
+						}
+					case "AVAILABILITY ZONE":
+						b.AvailabilityZone = o.optionValue()
+					}
+				}
+			}
+		}
+
+		brokers = append(brokers, b)
+		if !list {
+			break
+		}
+		p.acceptSymbol(",")
+	}
+
+	return brokers, nil
+}
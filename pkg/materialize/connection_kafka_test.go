@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 var connKafka = MaterializeObject{Name: "kafka_conn", SchemaName: "schema", DatabaseName: "database"}
@@ -225,3 +226,75 @@ func TestConnectionKafkaAwsPrivatelinkCreate(t *testing.T) {
 	})
 
 }
+
+func TestConnectionKafkaAwsConnectionCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."kafka_conn" TO KAFKA \(BROKERS \('b-1.hostname-1:9098'\), SECURITY PROTOCOL = 'SASL_SSL', AWS CONNECTION = "database"."schema"."aws_conn"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewConnectionKafkaBuilder(db, connKafka)
+		b.KafkaBrokers([]KafkaBroker{
+			{
+				Broker: "b-1.hostname-1:9098",
+			},
+		})
+		b.KafkaSecurityProtocol("SASL_SSL")
+		b.KafkaAwsConnection(IdentifierSchemaStruct{Name: "aws_conn", DatabaseName: "database", SchemaName: "schema"})
+		b.Validate(true)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestConnectionKafkaRoundTrip(t *testing.T) {
+	r := require.New(t)
+	ssh := IdentifierSchemaStruct{Name: "ssh_conn", SchemaName: "schema", DatabaseName: "database"}
+	privatelink := IdentifierSchemaStruct{Name: "privatelink_conn", SchemaName: "schema", DatabaseName: "database"}
+	brokers := []KafkaBroker{
+		{Broker: "b-1.hostname-1:9096", SSHTunnel: ssh},
+		{Broker: "b-2.hostname-2:9096", PrivateLinkConnection: privatelink, TargetGroupPort: 9001, AvailabilityZone: "use1-az1"},
+	}
+
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewConnectionKafkaBuilder(db, connKafka).
+			KafkaBrokers(brokers).
+			KafkaSecurityProtocol("SASL_SSL").
+			KafkaProgressTopic("topic").
+			KafkaSSLCa(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "ca", SchemaName: "schema", DatabaseName: "database"}}).
+			KafkaSSLCert(ValueSecretStruct{Text: "cert"}).
+			KafkaSSLKey(IdentifierSchemaStruct{Name: "key", SchemaName: "schema", DatabaseName: "database"}).
+			KafkaSASLMechanisms("PLAIN").
+			KafkaSASLUsername(ValueSecretStruct{Text: "user"}).
+			KafkaSASLPassword(IdentifierSchemaStruct{Name: "password", SchemaName: "schema", DatabaseName: "database"}).
+			Create()
+	})
+
+	c, err := ParseConnectionKafka(s)
+	r.NoError(err)
+	r.Equal(ConnectionKafkaDefinition{
+		KafkaBrokers:     brokers,
+		SecurityProtocol: "SASL_SSL",
+		ProgressTopic:    "topic",
+		SSLCa:            ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "ca", SchemaName: "schema", DatabaseName: "database"}},
+		SSLCert:          ValueSecretStruct{Text: "cert"},
+		SSLKey:           IdentifierSchemaStruct{Name: "key", SchemaName: "schema", DatabaseName: "database"},
+		SASLMechanisms:   "PLAIN",
+		SASLUsername:     ValueSecretStruct{Text: "user"},
+		SASLPassword:     IdentifierSchemaStruct{Name: "password", SchemaName: "schema", DatabaseName: "database"},
+	}, c)
+}
+
+func TestParseConnectionKafkaNormalized(t *testing.T) {
+	r := require.New(t)
+	c, err := ParseConnectionKafka(`CREATE CONNECTION "materialize"."public"."kafka_conn" TO KAFKA (BROKER = 'localhost:9092', SECURITY PROTOCOL = sasl_ssl, SASL MECHANISMS = 'SCRAM-SHA-256', SASL USERNAME = SECRET "materialize"."public"."user", SASL PASSWORD = SECRET "materialize"."public"."password", AWS CONNECTION = "materialize"."public"."aws_conn")`)
+	r.NoError(err)
+	r.Equal([]KafkaBroker{{Broker: "localhost:9092"}}, c.KafkaBrokers)
+	r.Equal("SASL_SSL", c.SecurityProtocol)
+	r.Equal("SCRAM-SHA-256", c.SASLMechanisms)
+	r.Equal(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "user", SchemaName: "public", DatabaseName: "materialize"}}, c.SASLUsername)
+	r.Equal(IdentifierSchemaStruct{Name: "password", SchemaName: "public", DatabaseName: "materialize"}, c.SASLPassword)
+	r.Equal(IdentifierSchemaStruct{Name: "aws_conn", SchemaName: "public", DatabaseName: "materialize"}, c.AwsConnection)
+}
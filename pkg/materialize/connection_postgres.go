@@ -147,3 +147,61 @@ func (b *ConnectionPostgresBuilder) Create() error {
 	q.WriteString(`;`)
 	return b.ddl.exec(q.String())
 }
+
+type ConnectionPostgresDefinition struct {
+	Database       string
+	Host           string
+	Port           int
+	User           ValueSecretStruct
+	Password       IdentifierSchemaStruct
+	SSHTunnel      IdentifierSchemaStruct
+	SSLCa          ValueSecretStruct
+	SSLCert        ValueSecretStruct
+	SSLKey         IdentifierSchemaStruct
+	SSLMode        string
+	AWSPrivateLink IdentifierSchemaStruct
+}
+
+func ParseConnectionPostgres(createSql string) (ConnectionPostgresDefinition, error) {
+	var c ConnectionPostgresDefinition
+
+	options, err := parseConnectionOptions(createSql, "POSTGRES")
+	if err != nil {
+		return c, err
+	}
+
+	for name, o := range options {
+		switch name {
+		case "DATABASE":
+			c.Database = o.optionValue()
+		case "HOST":
+			c.Host = o.optionValue()
+		case "PORT":
+			var v []int
+			if v, err = o.optionInts(); err == nil && len(v) > 0 {
+				c.Port = v[0]
+			}
+		case "USER":
+			c.User, err = o.optionValueSecret()
+		case "PASSWORD":
+			c.Password, err = o.optionIdentifier()
+		case "SSH TUNNEL":
+			c.SSHTunnel, err = o.optionIdentifier()
+		case "SSL CERTIFICATE AUTHORITY":
+			c.SSLCa, err = o.optionValueSecret()
+		case "SSL CERTIFICATE":
+			c.SSLCert, err = o.optionValueSecret()
+		case "SSL KEY":
+			c.SSLKey, err = o.optionIdentifier()
+		case "SSL MODE":
+			c.SSLMode = strings.ToLower(o.optionValue())
+		case "AWS PRIVATELINK":
+			c.AWSPrivateLink, err = o.optionIdentifier()
+		}
+		if err != nil {
+			return c, err
+		}
+	}
+
+	return c, nil
+}
@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 var connPostgres = MaterializeObject{Name: "postgres_conn", SchemaName: "schema", DatabaseName: "database"}
@@ -95,3 +96,36 @@ func TestConnectionPostgresSslCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestConnectionPostgresRoundTrip(t *testing.T) {
+	r := require.New(t)
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewConnectionPostgresBuilder(db, connPostgres).
+			PostgresHost("postgres_host").
+			PostgresPort(5432).
+			PostgresUser(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "user", SchemaName: "schema", DatabaseName: "database"}}).
+			PostgresPassword(IdentifierSchemaStruct{Name: "password", SchemaName: "schema", DatabaseName: "database"}).
+			PostgresDatabase("default").
+			PostgresSSLMode("verify-full").
+			PostgresSSLCa(ValueSecretStruct{Text: "ca"}).
+			PostgresSSLCert(ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "cert", SchemaName: "schema", DatabaseName: "database"}}).
+			PostgresSSLKey(IdentifierSchemaStruct{Name: "key", SchemaName: "schema", DatabaseName: "database"}).
+			PostgresSSHTunnel(IdentifierSchemaStruct{Name: "ssh_conn", SchemaName: "schema", DatabaseName: "database"}).
+			Create()
+	})
+
+	c, err := ParseConnectionPostgres(s)
+	r.NoError(err)
+	r.Equal(ConnectionPostgresDefinition{
+		Database:  "default",
+		Host:      "postgres_host",
+		Port:      5432,
+		User:      ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "user", SchemaName: "schema", DatabaseName: "database"}},
+		Password:  IdentifierSchemaStruct{Name: "password", SchemaName: "schema", DatabaseName: "database"},
+		SSHTunnel: IdentifierSchemaStruct{Name: "ssh_conn", SchemaName: "schema", DatabaseName: "database"},
+		SSLCa:     ValueSecretStruct{Text: "ca"},
+		SSLCert:   ValueSecretStruct{Secret: IdentifierSchemaStruct{Name: "cert", SchemaName: "schema", DatabaseName: "database"}},
+		SSLKey:    IdentifierSchemaStruct{Name: "key", SchemaName: "schema", DatabaseName: "database"},
+		SSLMode:   "verify-full",
+	}, c)
+}
@@ -94,3 +94,37 @@ func ScanConnectionSshTunnel(conn *sqlx.DB, id string) (ConnectionSshTunnelParam
 
 	return c, nil
 }
+
+type ConnectionSshTunnelDefinition struct {
+	Host string
+	User string
+	Port int
+}
+
+func ParseConnectionSshTunnel(createSql string) (ConnectionSshTunnelDefinition, error) {
+	var c ConnectionSshTunnelDefinition
+
+	options, err := parseConnectionOptions(createSql, "SSH", "TUNNEL")
+	if err != nil {
+		return c, err
+	}
+
+	for name, o := range options {
+		switch name {
+		case "HOST":
+			c.Host = o.optionValue()
+		case "USER":
+			c.User = o.optionValue()
+		case "PORT":
+			v, err := o.optionInts()
+			if err != nil {
+				return c, err
+			}
+			if len(v) > 0 {
+				c.Port = v[0]
+			}
+		}
+	}
+
+	return c, nil
+}
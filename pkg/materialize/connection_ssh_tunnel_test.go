@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConnectionSshTunnelCreate(t *testing.T) {
@@ -25,3 +26,15 @@ func TestConnectionSshTunnelCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestConnectionSshTunnelRoundTrip(t *testing.T) {
+	r := require.New(t)
+	o := MaterializeObject{Name: "ssh_conn", SchemaName: "schema", DatabaseName: "database"}
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewConnectionSshTunnelBuilder(db, o).SSHHost("localhost").SSHUser("user").SSHPort(123).Create()
+	})
+
+	c, err := ParseConnectionSshTunnel(s)
+	r.NoError(err)
+	r.Equal(ConnectionSshTunnelDefinition{Host: "localhost", User: "user", Port: 123}, c)
+}
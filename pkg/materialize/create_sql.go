@@ -0,0 +1,673 @@
+package materialize
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Helpers to read back the normalized `SHOW CREATE` statements Materialize
+// stores for each object. Only the clauses the provider manages are parsed,
+// anything else is skipped.
+
+// Identifiers that do not need to be quoted
+var simpleIdentifier = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+type sqlTokenKind int
+
+const (
+	sqlWord sqlTokenKind = iota
+	sqlIdent
+	sqlString
+	sqlNumber
+	sqlSymbol
+)
+
+type sqlToken struct {
+	kind  sqlTokenKind
+	value string
+	// offset of the token within the statement, in runes
+	offset int
+}
+
+func tokenizeSql(s string) ([]sqlToken, error) {
+	var tokens []sqlToken
+	r := []rune(s)
+
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			v := strings.Builder{}
+			start := i
+			i++
+			closed := false
+			for i < len(r) {
+				if r[i] == c {
+					// Doubled quotes are escaped quotes
+					if i+1 < len(r) && r[i+1] == c {
+						v.WriteRune(c)
+						i += 2
+						continue
+					}
+					closed = true
+					i++
+					break
+				}
+				v.WriteRune(r[i])
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quoted value in: %s", s)
+			}
+			kind := sqlString
+			if c == '"' {
+				kind = sqlIdent
+			}
+			tokens = append(tokens, sqlToken{kind, v.String(), start})
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(r) && r[j] >= '0' && r[j] <= '9' {
+				j++
+			}
+			// Decimal part, e.g. `SCALE FACTOR 0.01`
+			if j+1 < len(r) && r[j] == '.' && r[j+1] >= '0' && r[j+1] <= '9' {
+				j++
+				for j < len(r) && r[j] >= '0' && r[j] <= '9' {
+					j++
+				}
+			}
+			tokens = append(tokens, sqlToken{sqlNumber, string(r[i:j]), i})
+			i = j
+		case c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c > 127:
+			j := i
+			for j < len(r) && (r[j] == '_' || r[j] == '$' || (r[j] >= 'a' && r[j] <= 'z') || (r[j] >= 'A' && r[j] <= 'Z') || (r[j] >= '0' && r[j] <= '9') || r[j] > 127) {
+				j++
+			}
+			tokens = append(tokens, sqlToken{sqlWord, string(r[i:j]), i})
+			i = j
+		default:
+			tokens = append(tokens, sqlToken{sqlSymbol, string(c), i})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+type sqlParser struct {
+	source []rune
+	tokens []sqlToken
+	pos    int
+}
+
+func newSqlParser(s string) (*sqlParser, error) {
+	t, err := tokenizeSql(s)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlParser{source: []rune(s), tokens: t}, nil
+}
+
+func (p *sqlParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *sqlParser) peek(offset int) (sqlToken, bool) {
+	if p.pos+offset >= len(p.tokens) {
+		return sqlToken{}, false
+	}
+	return p.tokens[p.pos+offset], true
+}
+
+// peekKeyword reports if the next tokens are the given keywords.
+func (p *sqlParser) peekKeyword(keywords ...string) bool {
+	for i, k := range keywords {
+		t, ok := p.peek(i)
+		if !ok || t.kind != sqlWord || !strings.EqualFold(t.value, k) {
+			return false
+		}
+	}
+	return true
+}
+
+// acceptKeyword consumes the keywords if they are the next tokens.
+func (p *sqlParser) acceptKeyword(keywords ...string) bool {
+	if !p.peekKeyword(keywords...) {
+		return false
+	}
+	p.pos += len(keywords)
+	return true
+}
+
+// acceptKeywordBeforeSymbol consumes the keyword only if it is followed by
+// the symbol, which is left in place.
+func (p *sqlParser) acceptKeywordBeforeSymbol(keyword, symbol string) bool {
+	t, ok := p.peek(1)
+	if !p.peekKeyword(keyword) || !ok || t.kind != sqlSymbol || t.value != symbol {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+func (p *sqlParser) peekSymbol(s string) bool {
+	t, ok := p.peek(0)
+	return ok && t.kind == sqlSymbol && t.value == s
+}
+
+func (p *sqlParser) acceptSymbol(s string) bool {
+	if !p.peekSymbol(s) {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+func (p *sqlParser) expectSymbol(s string) error {
+	if !p.acceptSymbol(s) {
+		return p.unexpected(fmt.Sprintf("'%s'", s))
+	}
+	return nil
+}
+
+func (p *sqlParser) unexpected(expected string) error {
+	t, ok := p.peek(0)
+	if !ok {
+		return fmt.Errorf("expected %s, found end of statement", expected)
+	}
+	return fmt.Errorf("expected %s, found %q", expected, t.value)
+}
+
+func (p *sqlParser) parseIdentifier() (string, error) {
+	t, ok := p.peek(0)
+	if !ok || (t.kind != sqlIdent && t.kind != sqlWord) {
+		return "", p.unexpected("identifier")
+	}
+	p.pos++
+	if t.kind == sqlWord {
+		// Unquoted identifiers are case-insensitive
+		return strings.ToLower(t.value), nil
+	}
+	return t.value, nil
+}
+
+// parseQualifiedName reads a one to three part object name.
+func (p *sqlParser) parseQualifiedName() (IdentifierSchemaStruct, error) {
+	var parts []string
+	for {
+		n, err := p.parseIdentifier()
+		if err != nil {
+			return IdentifierSchemaStruct{}, err
+		}
+		parts = append(parts, n)
+		if len(parts) == 3 || !p.acceptSymbol(".") {
+			break
+		}
+	}
+
+	var i IdentifierSchemaStruct
+	i.Name = parts[len(parts)-1]
+	if len(parts) > 1 {
+		i.SchemaName = parts[len(parts)-2]
+	}
+	if len(parts) > 2 {
+		i.DatabaseName = parts[0]
+	}
+	return i, nil
+}
+
+// ParseNameParts splits a qualified name, such as `database.schema."Name"`,
+// into its parts. Unquoted parts are case-insensitive.
+func ParseNameParts(name string) ([]string, error) {
+	p, err := newSqlParser(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []string
+	for {
+		n, err := p.parseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, n)
+		if !p.acceptSymbol(".") {
+			break
+		}
+	}
+	if !p.done() {
+		return nil, p.unexpected("end of name")
+	}
+	return parts, nil
+}
+
+func (p *sqlParser) parseString() (string, error) {
+	t, ok := p.peek(0)
+	if !ok || t.kind != sqlString {
+		return "", p.unexpected("string literal")
+	}
+	p.pos++
+	return t.value, nil
+}
+
+func (p *sqlParser) parseInt() (int, error) {
+	sign := 1
+	if p.acceptSymbol("-") {
+		sign = -1
+	}
+	t, ok := p.peek(0)
+	if !ok || t.kind != sqlNumber {
+		return 0, p.unexpected("number")
+	}
+	p.pos++
+	v, err := strconv.Atoi(t.value)
+	if err != nil {
+		return 0, err
+	}
+	return sign * v, nil
+}
+
+// skip consumes a single token, or a parenthesized or bracketed group as a
+// whole.
+func (p *sqlParser) skip() {
+	depth := 0
+	for !p.done() {
+		t := p.tokens[p.pos]
+		p.pos++
+		if t.kind == sqlSymbol && (t.value == "(" || t.value == "[") {
+			depth++
+		} else if t.kind == sqlSymbol && (t.value == ")" || t.value == "]") {
+			depth--
+		}
+		if depth <= 0 {
+			return
+		}
+	}
+}
+
+// skipExpression consumes tokens up to the next top level `,` or `)` and
+// returns the original text of the expression.
+func (p *sqlParser) skipExpression() string {
+	start := p.pos
+	for !p.done() && !p.peekSymbol(",") && !p.peekSymbol(")") {
+		p.skip()
+	}
+	return p.text(start, p.pos)
+}
+
+// remainder consumes the rest of the statement and returns its original text
+// without the trailing semicolon.
+func (p *sqlParser) remainder() string {
+	start := p.pos
+	p.pos = len(p.tokens)
+	return strings.TrimSuffix(p.text(start, p.pos), ";")
+}
+
+// text returns the original text spanned by the tokens in [start, end).
+func (p *sqlParser) text(start, end int) string {
+	if start >= end || start >= len(p.tokens) {
+		return ""
+	}
+	to := len(p.source)
+	if end < len(p.tokens) {
+		to = p.tokens[end].offset
+	}
+	return strings.TrimSpace(string(p.source[p.tokens[start].offset:to]))
+}
+
+// skipType consumes a data type up to the next top level `,` or `)`.
+func (p *sqlParser) skipType() string {
+	start := p.pos
+	p.skipExpression()
+	return p.typeText(start, p.pos)
+}
+
+// typeText returns the data type spanned by the tokens in [start, end).
+// Materialize qualifies and quotes built-in types when normalizing, so
+// `"pg_catalog"."int4"` is read back as `int4`.
+func (p *sqlParser) typeText(start, end int) string {
+	b := strings.Builder{}
+	for i := start; i < end; i++ {
+		t := p.tokens[i]
+		to := len(p.source)
+		if i+1 < len(p.tokens) {
+			to = p.tokens[i+1].offset
+		}
+		s := string(p.source[t.offset:to])
+
+		if i+1 < end && p.tokens[i+1].kind == sqlSymbol && p.tokens[i+1].value == "." &&
+			(strings.EqualFold(t.value, "pg_catalog") || strings.EqualFold(t.value, "mz_catalog")) {
+			i++
+			continue
+		}
+		if t.kind == sqlIdent && simpleIdentifier.MatchString(t.value) {
+			s = t.value + s[len(t.value)+2:]
+		}
+		b.WriteString(s)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// EquivalentSql reports if two SQL fragments are made of the same tokens,
+// ignoring whitespace, the case of keywords and unnecessary identifier quotes.
+// Materialize reformats the expressions it stores, so they are compared this
+// way against the configured text.
+func EquivalentSql(a, b string) bool {
+	ta, err := tokenizeSql(a)
+	if err != nil {
+		return false
+	}
+	tb, err := tokenizeSql(b)
+	if err != nil {
+		return false
+	}
+	if len(ta) != len(tb) {
+		return false
+	}
+	for i := range ta {
+		if normalizeToken(ta[i]) != normalizeToken(tb[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeToken folds unquoted words and quoted simple identifiers into the
+// same lower case word.
+func normalizeToken(t sqlToken) sqlToken {
+	t.offset = 0
+	switch {
+	case t.kind == sqlWord:
+		t.value = strings.ToLower(t.value)
+	case t.kind == sqlIdent && simpleIdentifier.MatchString(t.value):
+		t.kind = sqlWord
+	}
+	return t
+}
+
+// parseIdentifierList reads a parenthesized, comma separated list of names.
+func (p *sqlParser) parseIdentifierList() ([]string, error) {
+	var l []string
+	if err := p.expectSymbol("("); err != nil {
+		return nil, err
+	}
+	for !p.acceptSymbol(")") {
+		n, err := p.parseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, n)
+		if !p.acceptSymbol(",") && !p.peekSymbol(")") {
+			return nil, p.unexpected("',' or ')'")
+		}
+	}
+	return l, nil
+}
+
+type sqlOption struct {
+	Name  string
+	Value []sqlToken
+	// statement the value tokens were read from
+	source []rune
+}
+
+// parseOptionList reads a parenthesized list of `NAME [=] value` options.
+// Option names are upper cased and values are kept as raw tokens.
+func (p *sqlParser) parseOptionList() ([]sqlOption, error) {
+	var options []sqlOption
+	if err := p.expectSymbol("("); err != nil {
+		return nil, err
+	}
+
+	for !p.acceptSymbol(")") {
+		var name []string
+		for {
+			t, ok := p.peek(0)
+			if !ok || t.kind != sqlWord {
+				break
+			}
+			name = append(name, strings.ToUpper(t.value))
+			p.pos++
+		}
+		p.acceptSymbol("=")
+
+		start := p.pos
+		for !p.done() && !p.peekSymbol(",") && !p.peekSymbol(")") {
+			p.skip()
+		}
+		if p.done() {
+			return nil, p.unexpected("')'")
+		}
+		options = append(options, sqlOption{Name: strings.Join(name, " "), Value: p.tokens[start:p.pos], source: p.source})
+		p.acceptSymbol(",")
+	}
+
+	return options, nil
+}
+
+// optionValue concatenates the values of the option tokens without
+// separators. Quotes were already removed by the tokenizer, so it is only
+// meant for options holding a single name or literal.
+func (o sqlOption) optionValue() string {
+	var v []string
+	for _, t := range o.Value {
+		v = append(v, t.value)
+	}
+	return strings.Join(v, "")
+}
+
+// parser returns a parser over the value tokens of the option.
+func (o sqlOption) parser() *sqlParser {
+	return &sqlParser{source: o.source, tokens: o.Value}
+}
+
+// optionIdentifier returns the object named by the option, ignoring the
+// `SECRET` keyword of secret references.
+func (o sqlOption) optionIdentifier() (IdentifierSchemaStruct, error) {
+	p := o.parser()
+	p.acceptKeyword("SECRET")
+	return p.parseQualifiedName()
+}
+
+// optionValueSecret returns the option as either a text value or a secret
+// reference.
+func (o sqlOption) optionValueSecret() (ValueSecretStruct, error) {
+	if !o.parser().peekKeyword("SECRET") {
+		return ValueSecretStruct{Text: o.optionValue()}, nil
+	}
+	s, err := o.optionIdentifier()
+	if err != nil {
+		return ValueSecretStruct{}, err
+	}
+	return ValueSecretStruct{Secret: s}, nil
+}
+
+func (o sqlOption) optionInts() ([]int, error) {
+	var v []int
+	for _, t := range o.Value {
+		if t.kind != sqlNumber {
+			continue
+		}
+		i, err := strconv.Atoi(t.value)
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, i)
+	}
+	return v, nil
+}
+
+// optionInt returns the first integer of the option, or 0 if it has none.
+func (o sqlOption) optionInt() (int, error) {
+	v, err := o.optionInts()
+	if err != nil || len(v) == 0 {
+		return 0, err
+	}
+	return v[0], nil
+}
+
+// parseConnectionOptions reads the options of a `CREATE CONNECTION ... TO
+// <kind> (...)` statement, keyed by option name. Secret references written
+// without an equals sign, e.g. `PASSWORD SECRET x`, are keyed by the option
+// name alone.
+func parseConnectionOptions(createSql string, kind ...string) (map[string]sqlOption, error) {
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return nil, err
+	}
+
+	for !p.done() && !p.peekKeyword(append([]string{"TO"}, kind...)...) {
+		p.skip()
+	}
+	if !p.acceptKeyword(append([]string{"TO"}, kind...)...) {
+		return nil, fmt.Errorf("expected connection to %s in: %s", strings.Join(kind, " "), createSql)
+	}
+
+	options, err := p.parseOptionList()
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]sqlOption{}
+	for _, o := range options {
+		if n, ok := strings.CutSuffix(o.Name, " SECRET"); ok {
+			o.Name = n
+			o.Value = append([]sqlToken{{kind: sqlWord, value: "SECRET"}}, o.Value...)
+		}
+		m[o.Name] = o
+	}
+	return m, nil
+}
+
+// parseFormatSpec reads the format specifier following a `FORMAT` keyword.
+// Schema registry seeds are skipped as they are populated by Materialize.
+func (p *sqlParser) parseFormatSpec() (SourceFormatSpecStruct, error) {
+	var f SourceFormatSpecStruct
+
+	switch {
+	case p.acceptKeyword("BYTES"):
+		f.Bytes = true
+	case p.acceptKeyword("TEXT"):
+		f.Text = true
+	case p.acceptKeyword("JSON"):
+		f.Json = true
+	case p.acceptKeyword("AVRO"):
+		f.Avro = &AvroFormatSpec{}
+		if p.acceptKeyword("USING", "CONFLUENT", "SCHEMA", "REGISTRY", "CONNECTION") {
+			csr, err := p.parseQualifiedName()
+			if err != nil {
+				return f, err
+			}
+			f.Avro.SchemaRegistryConnection = csr
+		}
+		for {
+			if p.peekSymbol("(") {
+				p.skip()
+			} else if p.acceptKeyword("SEED") {
+				p.skipSeed()
+			} else if p.acceptKeyword("KEY", "STRATEGY") {
+				f.Avro.KeyStrategy = p.parseStrategy()
+			} else if p.acceptKeyword("VALUE", "STRATEGY") {
+				f.Avro.ValueStrategy = p.parseStrategy()
+			} else {
+				break
+			}
+		}
+	case p.acceptKeyword("PROTOBUF"):
+		f.Protobuf = &ProtobufFormatSpec{}
+		if p.acceptKeyword("MESSAGE") {
+			m, err := p.parseString()
+			if err != nil {
+				return f, err
+			}
+			f.Protobuf.MessageName = m
+		}
+		if p.acceptKeyword("USING", "CONFLUENT", "SCHEMA", "REGISTRY", "CONNECTION") {
+			csr, err := p.parseQualifiedName()
+			if err != nil {
+				return f, err
+			}
+			f.Protobuf.SchemaRegistryConnection = csr
+		}
+		if p.acceptKeyword("SEED") {
+			p.skipSeed()
+		}
+	case p.acceptKeyword("CSV"):
+		f.Csv = &CsvFormatSpec{}
+		if p.acceptKeyword("WITH", "HEADER") {
+			h, err := p.parseIdentifierList()
+			if err != nil {
+				return f, err
+			}
+			f.Csv.Header = h
+		} else if p.acceptKeyword("WITH") {
+			c, err := p.parseInt()
+			if err != nil {
+				return f, err
+			}
+			f.Csv.Columns = c
+			p.acceptKeyword("COLUMNS")
+		}
+		if p.acceptKeyword("DELIMITED", "BY") || p.acceptKeyword("DELIMITER") {
+			d, err := p.parseString()
+			if err != nil {
+				return f, err
+			}
+			f.Csv.DelimitedBy = d
+		}
+	default:
+		return f, p.unexpected("format")
+	}
+
+	return f, nil
+}
+
+func (p *sqlParser) skipSeed() {
+	for {
+		if p.acceptKeyword("KEY", "SCHEMA") || p.acceptKeyword("VALUE", "SCHEMA") || p.acceptKeyword("MESSAGE") {
+			if _, err := p.parseString(); err != nil {
+				return
+			}
+			continue
+		}
+		return
+	}
+}
+
+func (p *sqlParser) parseStrategy() string {
+	t, ok := p.peek(0)
+	if !ok || t.kind != sqlWord {
+		return ""
+	}
+	p.pos++
+	s := strings.ToUpper(t.value)
+
+	// Strategies can carry an argument, e.g. `ID 1` or `INLINE '<schema>'`
+	if n, ok := p.peek(0); ok && (n.kind == sqlNumber || n.kind == sqlString) {
+		p.pos++
+	}
+	return s
+}
+
+type showCreateParams struct {
+	Name      string `db:"name"`
+	CreateSql string `db:"create_sql"`
+}
+
+// ShowCreate returns the normalized create statement of an object.
+func ShowCreate(conn *sqlx.DB, objectType EntityType, qualifiedName string) (string, error) {
+	q := fmt.Sprintf(`SHOW CREATE %s %s;`, objectType, qualifiedName)
+
+	var s showCreateParams
+	if err := conn.Get(&s, q); err != nil {
+		return "", err
+	}
+
+	return s.CreateSql, nil
+}
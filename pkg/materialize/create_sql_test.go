@@ -0,0 +1,126 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+// createStatement returns the statement a builder executes, so that it can
+// be round-tripped through the matching parser.
+func createStatement(t *testing.T, create func(*sqlx.DB) error) string {
+	t.Helper()
+	r := require.New(t)
+
+	var statement string
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherFunc(func(_, actual string) error {
+		statement = actual
+		return nil
+	})))
+	r.NoError(err)
+	defer db.Close()
+
+	mock.ExpectExec("").WillReturnResult(sqlmock.NewResult(1, 1))
+	r.NoError(create(sqlx.NewDb(db, "sqlmock")))
+	return statement
+}
+
+func TestTokenizeSql(t *testing.T) {
+	r := require.New(t)
+	tokens, err := tokenizeSql(`CREATE SOURCE "db"."my ""src""" (TOPIC = 'it''s', START OFFSET = (1, 20))`)
+	r.NoError(err)
+	r.Equal(14, tokens[2].offset)
+	for i := range tokens {
+		tokens[i].offset = 0
+	}
+	r.Equal([]sqlToken{
+		{sqlWord, "CREATE", 0}, {sqlWord, "SOURCE", 0},
+		{sqlIdent, "db", 0}, {sqlSymbol, ".", 0}, {sqlIdent, `my "src"`, 0},
+		{sqlSymbol, "(", 0}, {sqlWord, "TOPIC", 0}, {sqlSymbol, "=", 0}, {sqlString, "it's", 0}, {sqlSymbol, ",", 0},
+		{sqlWord, "START", 0}, {sqlWord, "OFFSET", 0}, {sqlSymbol, "=", 0},
+		{sqlSymbol, "(", 0}, {sqlNumber, "1", 0}, {sqlSymbol, ",", 0}, {sqlNumber, "20", 0}, {sqlSymbol, ")", 0},
+		{sqlSymbol, ")", 0},
+	}, tokens)
+}
+
+func TestTokenizeSqlUnterminated(t *testing.T) {
+	r := require.New(t)
+	_, err := tokenizeSql(`CREATE SOURCE "src (TOPIC = 'topic')`)
+	r.Error(err)
+}
+
+func TestParseQualifiedName(t *testing.T) {
+	r := require.New(t)
+	p, err := newSqlParser(`"database"."schema"."name" public.other item`)
+	r.NoError(err)
+
+	i, err := p.parseQualifiedName()
+	r.NoError(err)
+	r.Equal(IdentifierSchemaStruct{Name: "name", SchemaName: "schema", DatabaseName: "database"}, i)
+
+	i, err = p.parseQualifiedName()
+	r.NoError(err)
+	r.Equal(IdentifierSchemaStruct{Name: "other", SchemaName: "public"}, i)
+
+	i, err = p.parseQualifiedName()
+	r.NoError(err)
+	r.Equal(IdentifierSchemaStruct{Name: "item"}, i)
+}
+
+func TestParseOptionList(t *testing.T) {
+	r := require.New(t)
+	p, err := newSqlParser(`(TOPIC = 'topic', COMPRESSION TYPE = gzip, VALUE DECODING ERRORS = (INLINE AS "e")) ENVELOPE`)
+	r.NoError(err)
+
+	o, err := p.parseOptionList()
+	r.NoError(err)
+	r.Len(o, 3)
+	r.Equal("TOPIC", o[0].Name)
+	r.Equal("topic", o[0].optionValue())
+	r.Equal("COMPRESSION TYPE", o[1].Name)
+	r.Equal("gzip", o[1].optionValue())
+	r.Equal("VALUE DECODING ERRORS", o[2].Name)
+	r.True(p.peekKeyword("ENVELOPE"))
+}
+
+func TestParseFormatSpec(t *testing.T) {
+	r := require.New(t)
+	p, err := newSqlParser(`AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "materialize"."public"."csr" SEED KEY SCHEMA '{}' VALUE SCHEMA '{}' VALUE STRATEGY LATEST CSV WITH 2 COLUMNS DELIMITED BY '|' PROTOBUF MESSAGE 'Batch' USING CONFLUENT SCHEMA REGISTRY CONNECTION "csr"`)
+	r.NoError(err)
+
+	f, err := p.parseFormatSpec()
+	r.NoError(err)
+	r.Equal(&AvroFormatSpec{
+		SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr", SchemaName: "public", DatabaseName: "materialize"},
+		ValueStrategy:            "LATEST",
+	}, f.Avro)
+
+	f, err = p.parseFormatSpec()
+	r.NoError(err)
+	r.Equal(&CsvFormatSpec{Columns: 2, DelimitedBy: "|"}, f.Csv)
+
+	f, err = p.parseFormatSpec()
+	r.NoError(err)
+	r.Equal(&ProtobufFormatSpec{SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr"}, MessageName: "Batch"}, f.Protobuf)
+	r.True(p.done())
+}
+
+func TestParseNameParts(t *testing.T) {
+	r := require.New(t)
+
+	n, err := ParseNameParts(`Materialize.public."My.View"`)
+	r.NoError(err)
+	r.Equal([]string{"materialize", "public", "My.View"}, n)
+
+	n, err = ParseNameParts("cluster")
+	r.NoError(err)
+	r.Equal([]string{"cluster"}, n)
+
+	_, err = ParseNameParts("schema.")
+	r.Error(err)
+
+	_, err = ParseNameParts("schema view")
+	r.Error(err)
+}
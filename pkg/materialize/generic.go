@@ -25,6 +25,7 @@ const (
 	BaseSink         EntityType = "SINK"
 	BaseSource       EntityType = "SOURCE"
 	Secret           EntityType = "SECRET"
+	SystemParameter  EntityType = "SYSTEM PARAMETER"
 	Table            EntityType = "TABLE"
 	BaseType         EntityType = "TYPE"
 	View             EntityType = "VIEW"
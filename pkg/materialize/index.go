@@ -102,6 +102,11 @@ func (b *IndexBuilder) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+func (b *IndexBuilder) Rename(newName string) error {
+	n := QualifiedName(newName)
+	return b.ddl.rename(b.QualifiedName(), n)
+}
+
 func (b *IndexBuilder) Drop() error {
 	q := fmt.Sprintf(`DROP INDEX %s RESTRICT;`, b.QualifiedName())
 	return b.ddl.exec(q)
@@ -120,6 +125,7 @@ type IndexParams struct {
 	ObjectName         sql.NullString `db:"obj_name"`
 	ObjectSchemaName   sql.NullString `db:"obj_schema_name"`
 	ObjectDatabaseName sql.NullString `db:"obj_database_name"`
+	ClusterName        sql.NullString `db:"cluster_name"`
 	Comment            sql.NullString `db:"comment"`
 }
 
@@ -130,6 +136,7 @@ var indexQuery = NewBaseQuery(`
 		mz_objects.name AS obj_name,
 		mz_schemas.name AS obj_schema_name,
 		mz_databases.name AS obj_database_name,
+		mz_clusters.name AS cluster_name,
 		comments.comment AS comment
 	FROM mz_indexes
 	JOIN mz_objects
@@ -138,6 +145,8 @@ var indexQuery = NewBaseQuery(`
 		ON mz_objects.schema_id = mz_schemas.id
 	LEFT JOIN mz_databases
 		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_clusters
+		ON mz_indexes.cluster_id = mz_clusters.id
 	LEFT JOIN (
 		SELECT id, comment
 		FROM mz_internal.mz_comments
@@ -146,8 +155,15 @@ var indexQuery = NewBaseQuery(`
 		ON mz_indexes.id = comments.id`).
 	CustomPredicate([]string{"mz_objects.type IN ('source', 'view', 'materialized-view')"})
 
-func IndexId(conn *sqlx.DB, indexName string) (string, error) {
-	q := indexQuery.QueryPredicate(map[string]string{"mz_indexes.name": indexName})
+// IndexId returns the id of an index. Indexes belong to the schema of the
+// indexed object.
+func IndexId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+	p := map[string]string{
+		"mz_indexes.name":   obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+	q := indexQuery.QueryPredicate(p)
 
 	var c IndexParams
 	if err := conn.Get(&c, q); err != nil {
@@ -183,3 +199,99 @@ func ListIndexes(conn *sqlx.DB, schemaName, databaseName string) ([]IndexParams,
 
 	return c, nil
 }
+
+// ListObjectIndexes returns the indexes on a source, view or materialized view.
+func ListObjectIndexes(conn *sqlx.DB, obj IdentifierSchemaStruct) ([]IndexParams, error) {
+	p := map[string]string{
+		"mz_objects.name":   obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+
+	q := indexQuery.QueryPredicate(p)
+
+	var c []IndexParams
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// Key of an index, either a column of the indexed object or an expression
+type IndexKeyParams struct {
+	Position   sql.NullInt64  `db:"index_position"`
+	Expression sql.NullString `db:"expression"`
+}
+
+var indexKeyQuery = NewBaseQuery(`
+	SELECT
+		mz_index_columns.index_position,
+		COALESCE(mz_index_columns.on_expression, mz_columns.name) AS expression
+	FROM mz_index_columns
+	JOIN mz_indexes
+		ON mz_index_columns.index_id = mz_indexes.id
+	LEFT JOIN mz_columns
+		ON mz_indexes.on_id = mz_columns.id
+		AND mz_index_columns.on_position = mz_columns.position`).Order("mz_index_columns.index_position")
+
+// ListIndexKeys returns the key of an index in order.
+func ListIndexKeys(conn *sqlx.DB, indexId string) ([]IndexKeyParams, error) {
+	q := indexKeyQuery.QueryPredicate(map[string]string{"mz_index_columns.index_id": indexId})
+
+	var c []IndexKeyParams
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type IndexDefinition struct {
+	Default     bool
+	ClusterName string
+	ObjName     IdentifierSchemaStruct
+	Method      string
+	ColExpr     []IndexColumn
+}
+
+func ParseIndex(createSql string) (IndexDefinition, error) {
+	var i IndexDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return i, err
+	}
+
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("DEFAULT", "INDEX"):
+			i.Default = true
+		case p.acceptKeyword("IN", "CLUSTER"):
+			if i.ClusterName, err = p.parseIdentifier(); err != nil {
+				return i, err
+			}
+		case p.acceptKeyword("ON"):
+			if i.ObjName, err = p.parseQualifiedName(); err != nil {
+				return i, err
+			}
+		case p.acceptKeyword("USING"):
+			m, err := p.parseIdentifier()
+			if err != nil {
+				return i, err
+			}
+			i.Method = strings.ToUpper(m)
+		case p.acceptSymbol("("):
+			for !p.done() && !p.acceptSymbol(")") {
+				if e := p.skipExpression(); e != "" {
+					i.ColExpr = append(i.ColExpr, IndexColumn{Field: e})
+				}
+				p.acceptSymbol(",")
+			}
+		default:
+			p.skip()
+		}
+	}
+
+	return i, nil
+}
@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 // https://materialize.com/docs/sql/create-index/
@@ -66,6 +67,18 @@ func TestIndexDefaultCreate(t *testing.T) {
 	})
 }
 
+func TestIndexRename(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index_tmp" RENAME TO "index";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "index_tmp"}
+		b := NewIndexBuilder(db, o, false, IdentifierSchemaStruct{SchemaName: "schema", Name: "source", DatabaseName: "database"})
+		if err := b.Rename("index"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestIndexDrop(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -89,3 +102,24 @@ func TestIndexComment(t *testing.T) {
 		}
 	})
 }
+
+func TestIndexRoundTrip(t *testing.T) {
+	r := require.New(t)
+	o := MaterializeObject{Name: "index", SchemaName: "schema", DatabaseName: "database"}
+	obj := IdentifierSchemaStruct{Name: "source", SchemaName: "schema", DatabaseName: "database"}
+	columns := []IndexColumn{{Field: "a"}, {Field: "lower(b, ',')"}}
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewIndexBuilder(db, o, false, obj).ClusterName("cluster").Method("ARRANGEMENT").ColExpr(columns).Create()
+	})
+
+	i, err := ParseIndex(s)
+	r.NoError(err)
+	r.Equal(IndexDefinition{ClusterName: "cluster", ObjName: obj, Method: "ARRANGEMENT", ColExpr: columns}, i)
+
+	s = createStatement(t, func(db *sqlx.DB) error {
+		return NewIndexBuilder(db, o, true, obj).Create()
+	})
+	i, err = ParseIndex(s)
+	r.NoError(err)
+	r.Equal(IndexDefinition{Default: true, ObjName: obj}, i)
+}
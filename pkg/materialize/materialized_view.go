@@ -164,3 +164,48 @@ func ListMaterializedViews(conn *sqlx.DB, schemaName, databaseName string) ([]Ma
 
 	return c, nil
 }
+
+type MaterializedViewDefinition struct {
+	ClusterName       string
+	NotNullAssertions []string
+	Statement         string
+}
+
+func ParseMaterializedView(createSql string) (MaterializedViewDefinition, error) {
+	var m MaterializedViewDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return m, err
+	}
+
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("IN", "CLUSTER"):
+			if m.ClusterName, err = p.parseIdentifier(); err != nil {
+				return m, err
+			}
+		case p.acceptKeywordBeforeSymbol("WITH", "("):
+			p.acceptSymbol("(")
+			for !p.done() && !p.acceptSymbol(")") {
+				if p.acceptKeyword("ASSERT", "NOT", "NULL") {
+					p.acceptSymbol("=")
+					n, err := p.parseIdentifier()
+					if err != nil {
+						return m, err
+					}
+					m.NotNullAssertions = append(m.NotNullAssertions, n)
+				} else {
+					p.skipExpression()
+				}
+				p.acceptSymbol(",")
+			}
+		case p.acceptKeyword("AS"):
+			m.Statement = p.remainder()
+		default:
+			p.skip()
+		}
+	}
+
+	return m, nil
+}
@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMaterializedViewCreate(t *testing.T) {
@@ -36,3 +37,23 @@ func TestMaterializedViewDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestMaterializedViewRoundTrip(t *testing.T) {
+	r := require.New(t)
+	o := MaterializeObject{Name: "materialized_view", SchemaName: "schema", DatabaseName: "database"}
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewMaterializedViewBuilder(db, o).
+			ClusterName("cluster").
+			NotNullAssertions([]string{"a", "B"}).
+			SelectStmt("SELECT a, B FROM t1").
+			Create()
+	})
+
+	m, err := ParseMaterializedView(s)
+	r.NoError(err)
+	r.Equal(MaterializedViewDefinition{
+		ClusterName:       "cluster",
+		NotNullAssertions: []string{"a", "B"},
+		Statement:         "SELECT a, B FROM t1",
+	}, m)
+}
@@ -1,6 +1,10 @@
 package materialize
 
-import "github.com/jmoiron/sqlx"
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
 
 // Any Materialize Database Object. Will contain name and optionally database and schema
 // Cluster name only applies to cluster replicas
@@ -89,6 +93,21 @@ func ObjectId(conn *sqlx.DB, object MaterializeObject) (string, error) {
 
 	case "CLUSTER":
 		i, e = ClusterId(conn, object)
+
+	case "CLUSTER REPLICA":
+		i, e = ClusterReplicaId(conn, object)
+
+	case "SINK":
+		i, e = SinkId(conn, object)
+
+	case "INDEX":
+		i, e = IndexId(conn, object)
+
+	case "ROLE":
+		i, e = RoleId(conn, object.Name)
+
+	default:
+		e = fmt.Errorf("unsupported object type %s", t)
 	}
 
 	if e != nil {
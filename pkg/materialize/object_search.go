@@ -0,0 +1,127 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Types of the objects in mz_objects
+var ObjectTypes = []string{
+	"connection",
+	"function",
+	"index",
+	"materialized-view",
+	"secret",
+	"sink",
+	"source",
+	"table",
+	"type",
+	"view",
+}
+
+// Filters of a search of the objects across databases and schemas. Empty
+// filters match every object.
+type ObjectSearch struct {
+	Types                []string
+	DatabaseName         string
+	SchemaName           string
+	NameRegex            string
+	OwnerName            string
+	ClusterName          string
+	CommentRegex         string
+	IncludeSystemObjects bool
+}
+
+type ObjectParams struct {
+	ObjectId     sql.NullString `db:"id"`
+	ObjectName   sql.NullString `db:"name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	ObjectType   sql.NullString `db:"type"`
+	OwnerName    sql.NullString `db:"owner_name"`
+	ClusterName  sql.NullString `db:"cluster_name"`
+	Comment      sql.NullString `db:"comment"`
+	CreatedAt    sql.NullString `db:"created_at"`
+}
+
+// The qualified name of the object. Objects of system schemas do not belong
+// to a database.
+func (p ObjectParams) QualifiedName() string {
+	if !p.DatabaseName.Valid {
+		return QualifiedName(p.SchemaName.String, p.ObjectName.String)
+	}
+	return QualifiedName(p.DatabaseName.String, p.SchemaName.String, p.ObjectName.String)
+}
+
+const objectSearchStatement = `
+	SELECT
+		mz_objects.id,
+		mz_objects.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_objects.type,
+		mz_roles.name AS owner_name,
+		mz_clusters.name AS cluster_name,
+		comments.comment AS comment,
+		mz_object_history.created_at
+	FROM mz_objects
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	LEFT JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_roles
+		ON mz_objects.owner_id = mz_roles.id
+	LEFT JOIN mz_clusters
+		ON mz_objects.cluster_id = mz_clusters.id
+	LEFT JOIN mz_internal.mz_object_history
+		ON mz_objects.id = mz_object_history.id
+	LEFT JOIN (
+		SELECT id, object_type, comment
+		FROM mz_internal.mz_comments
+		WHERE object_sub_id IS NULL
+	) comments
+		ON mz_objects.id = comments.id
+		AND mz_objects.type = comments.object_type`
+
+// ListObjects searches the objects of every database and schema.
+func ListObjects(conn *sqlx.DB, s ObjectSearch) ([]ObjectParams, error) {
+	p := map[string]string{
+		"mz_databases.name": s.DatabaseName,
+		"mz_schemas.name":   s.SchemaName,
+		"mz_roles.name":     s.OwnerName,
+		"mz_clusters.name":  s.ClusterName,
+	}
+
+	var c []string
+	if len(s.Types) > 0 {
+		var t []string
+		for _, v := range s.Types {
+			t = append(t, QuoteString(v))
+		}
+		c = append(c, fmt.Sprintf("mz_objects.type IN (%s)", strings.Join(t, ", ")))
+	}
+	if s.NameRegex != "" {
+		c = append(c, fmt.Sprintf("mz_objects.name ~ %s", QuoteString(s.NameRegex)))
+	}
+	if s.CommentRegex != "" {
+		c = append(c, fmt.Sprintf("comments.comment ~ %s", QuoteString(s.CommentRegex)))
+	}
+	if !s.IncludeSystemObjects {
+		c = append(c, "mz_objects.id LIKE 'u%'")
+	}
+
+	q := NewBaseQuery(objectSearchStatement).
+		CustomPredicate(c).
+		Order("mz_databases.name, mz_schemas.name, mz_objects.name").
+		QueryPredicate(p)
+
+	var o []ObjectParams
+	if err := conn.Select(&o, q); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
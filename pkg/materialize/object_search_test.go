@@ -0,0 +1,60 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListObjects(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE mz_objects.id LIKE 'u%'`
+		testhelpers.MockObjectSearchScan(mock, p)
+
+		o, err := ListObjects(db, ObjectSearch{})
+		r.NoError(err)
+		r.Len(o, 2)
+		r.Equal(`"database"."schema"."orders"`, o[0].QualifiedName())
+		r.Equal("cluster", o[0].ClusterName.String)
+		r.False(o[1].ClusterName.Valid)
+	})
+}
+
+func TestListObjectsFilters(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		p := `WHERE comments.comment ~ 'customer'
+		AND mz_clusters.name = 'cluster'
+		AND mz_databases.name = 'database'
+		AND mz_objects.name ~ '\^orders'
+		AND mz_objects.type IN \('materialized-view', 'view'\)
+		AND mz_roles.name = 'owner'
+		AND mz_schemas.name = 'schema'`
+		testhelpers.MockObjectSearchScan(mock, p)
+
+		_, err := ListObjects(db, ObjectSearch{
+			Types:                []string{"materialized-view", "view"},
+			DatabaseName:         "database",
+			SchemaName:           "schema",
+			NameRegex:            "^orders",
+			OwnerName:            "owner",
+			ClusterName:          "cluster",
+			CommentRegex:         "customer",
+			IncludeSystemObjects: true,
+		})
+		r.NoError(err)
+	})
+}
+
+func TestObjectQualifiedNameSystemSchema(t *testing.T) {
+	r := require.New(t)
+
+	o := ObjectParams{}
+	o.ObjectName.String, o.ObjectName.Valid = "mz_tables", true
+	o.SchemaName.String, o.SchemaName.Valid = "mz_catalog", true
+	r.Equal(`"mz_catalog"."mz_tables"`, o.QualifiedName())
+}
@@ -39,3 +39,18 @@ func TestObjectId(t *testing.T) {
 		}
 	})
 }
+
+func TestObjectIdIndex(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		o := MaterializeObject{ObjectType: "INDEX", Name: "index", SchemaName: "schema", DatabaseName: "database"}
+
+		// Query Id, in the schema of the index
+		ip := `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
+		testhelpers.MockIndexScan(mock, ip)
+
+		i, err := ObjectId(db, o)
+		r.NoError(err)
+		r.Equal("u1", i)
+	})
+}
@@ -73,3 +73,22 @@ func ParseRolePrivileges(privileges []RolePrivilegeParams) (map[string][]string,
 
 	return mapping, nil
 }
+
+var roleMembershipQuery = NewBaseQuery(`
+	SELECT mz_roles.name AS role_name
+	FROM mz_role_members
+	JOIN mz_roles
+		ON mz_role_members.role_id = mz_roles.id`)
+
+// ListRoleMemberships returns the names of the roles granted to a member.
+func ListRoleMemberships(conn *sqlx.DB, memberId string) ([]string, error) {
+	p := map[string]string{"mz_role_members.member": memberId}
+	q := roleMembershipQuery.QueryPredicate(p)
+
+	var c []string
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
@@ -62,3 +62,18 @@ func TestRolePrivilegeRevoke(t *testing.T) {
 		}
 	})
 }
+
+func TestListRoleMemberships(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleMembershipScan(mock, `WHERE mz_role_members.member = 'u1'`)
+
+		roles, err := ListRoleMemberships(db, "u1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(roles, []string{"analyst", "dev_role"}) {
+			t.Fatalf("unexpected roles %v", roles)
+		}
+	})
+}
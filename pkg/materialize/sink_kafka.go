@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -203,3 +204,136 @@ func (b *SinkKafkaBuilder) Create() error {
 
 	return b.ddl.exec(q.String())
 }
+
+// Configuration of an existing Kafka sink, as reported by the catalog
+type SinkKafkaDefinition struct {
+	From            IdentifierSchemaStruct
+	KafkaConnection IdentifierSchemaStruct
+	Topic           string
+	CompressionType string
+	Key             []string
+	KeyNotEnforced  bool
+	Format          SinkFormatSpecStruct
+	Envelope        KafkaSinkEnvelopeStruct
+}
+
+func ParseSinkKafka(createSql string) (SinkKafkaDefinition, error) {
+	var s SinkKafkaDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return s, err
+	}
+
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("INTO", "KAFKA", "CONNECTION"):
+			if s.KafkaConnection, err = p.parseQualifiedName(); err != nil {
+				return s, err
+			}
+			if !p.peekSymbol("(") {
+				continue
+			}
+			options, err := p.parseOptionList()
+			if err != nil {
+				return s, err
+			}
+			for _, o := range options {
+				switch o.Name {
+				case "TOPIC":
+					s.Topic = o.optionValue()
+				case "COMPRESSION TYPE":
+					s.CompressionType = strings.ToLower(o.optionValue())
+				}
+			}
+		case p.acceptKeyword("FROM"):
+			if s.From, err = p.parseQualifiedName(); err != nil {
+				return s, err
+			}
+		case p.acceptKeywordBeforeSymbol("KEY", "("):
+			if s.Key, err = p.parseIdentifierList(); err != nil {
+				return s, err
+			}
+			s.KeyNotEnforced = p.acceptKeyword("NOT", "ENFORCED")
+		case p.acceptKeyword("FORMAT", "JSON"):
+			s.Format.Json = true
+		case p.acceptKeyword("FORMAT", "AVRO", "USING", "CONFLUENT", "SCHEMA", "REGISTRY", "CONNECTION"):
+			s.Format.Avro = &SinkAvroFormatSpec{}
+			if s.Format.Avro.SchemaRegistryConnection, err = p.parseQualifiedName(); err != nil {
+				return s, err
+			}
+			if !p.peekSymbol("(") {
+				continue
+			}
+			options, err := p.parseOptionList()
+			if err != nil {
+				return s, err
+			}
+			for _, o := range options {
+				switch o.Name {
+				case "AVRO KEY FULLNAME":
+					s.Format.Avro.AvroKeyFullname = o.optionValue()
+				case "AVRO VALUE FULLNAME":
+					s.Format.Avro.AvroValueFullname = o.optionValue()
+				}
+			}
+		case p.acceptKeyword("ENVELOPE", "DEBEZIUM"):
+			s.Envelope.Debezium = true
+		case p.acceptKeyword("ENVELOPE", "UPSERT"):
+			s.Envelope.Upsert = true
+		default:
+			p.skip()
+		}
+	}
+
+	return s, nil
+}
+
+type SinkKafkaParams struct {
+	SinkId       sql.NullString `db:"id"`
+	SinkName     sql.NullString `db:"name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	Topic        sql.NullString `db:"topic"`
+}
+
+var sinkKafkaQuery = NewBaseQuery(`
+	SELECT
+		mz_sinks.id,
+		mz_sinks.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_kafka_sinks.topic
+	FROM mz_sinks
+	JOIN mz_schemas
+		ON mz_sinks.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_kafka_sinks
+		ON mz_sinks.id = mz_kafka_sinks.id`)
+
+func ScanSinkKafka(conn *sqlx.DB, id string) (SinkKafkaDefinition, error) {
+	q := sinkKafkaQuery.QueryPredicate(map[string]string{"mz_sinks.id": id})
+
+	var c SinkKafkaParams
+	if err := conn.Get(&c, q); err != nil {
+		return SinkKafkaDefinition{}, err
+	}
+
+	qn := QualifiedName(c.DatabaseName.String, c.SchemaName.String, c.SinkName.String)
+	createSql, err := ShowCreate(conn, BaseSink, qn)
+	if err != nil {
+		return SinkKafkaDefinition{}, err
+	}
+
+	s, err := ParseSinkKafka(createSql)
+	if err != nil {
+		return s, fmt.Errorf("unable to parse definition of sink %s: %w", qn, err)
+	}
+
+	if c.Topic.Valid {
+		s.Topic = c.Topic.String
+	}
+
+	return s, nil
+}
@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 // https://github.com/MaterializeInc/materialize/blob/main/test/testdrive/kafka-sinks.td
@@ -360,3 +361,20 @@ func TestSinkKafkaAvroDocsCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestParseSinkKafka(t *testing.T) {
+	r := require.New(t)
+	s, err := ParseSinkKafka(`CREATE SINK "database"."schema"."sink" IN CLUSTER "cluster" FROM "database"."public"."table" INTO KAFKA CONNECTION "database"."schema"."kafka_connection" (TOPIC = 'events', COMPRESSION TYPE = LZ4) KEY ("a", "b") NOT ENFORCED FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection" (AVRO KEY FULLNAME = 'key', AVRO VALUE FULLNAME = 'value') ENVELOPE DEBEZIUM WITH (SNAPSHOT = false)`)
+	r.NoError(err)
+
+	r.Equal(IdentifierSchemaStruct{Name: "table", SchemaName: "public", DatabaseName: "database"}, s.From)
+	r.Equal("kafka_connection", s.KafkaConnection.Name)
+	r.Equal("events", s.Topic)
+	r.Equal("lz4", s.CompressionType)
+	r.Equal([]string{"a", "b"}, s.Key)
+	r.True(s.KeyNotEnforced)
+	r.Equal("csr_connection", s.Format.Avro.SchemaRegistryConnection.Name)
+	r.Equal("key", s.Format.Avro.AvroKeyFullname)
+	r.Equal("value", s.Format.Avro.AvroValueFullname)
+	r.True(s.Envelope.Debezium)
+}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"database/sql"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,10 +9,41 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+type ValueDecodingErrorsStruct struct {
+	Inline bool
+	Alias  string
+}
+
+type UpsertOptionsStruct struct {
+	ValueDecodingErrors ValueDecodingErrorsStruct
+}
+
 type KafkaSourceEnvelopeStruct struct {
-	Debezium bool
-	None     bool
-	Upsert   bool
+	Debezium      bool
+	None          bool
+	Upsert        bool
+	UpsertOptions UpsertOptionsStruct
+}
+
+func GetUpsertOptionsStruct(v interface{}) UpsertOptionsStruct {
+	var options UpsertOptionsStruct
+	if v == nil || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return options
+	}
+	u := v.([]interface{})[0].(map[string]interface{})
+	if vde, ok := u["value_decoding_errors"]; ok && len(vde.([]interface{})) > 0 && vde.([]interface{})[0] != nil {
+		i := vde.([]interface{})[0].(map[string]interface{})["inline"]
+		if i != nil && len(i.([]interface{})) > 0 && i.([]interface{})[0] != nil {
+			inline := i.([]interface{})[0].(map[string]interface{})
+			if v, ok := inline["enabled"]; ok {
+				options.ValueDecodingErrors.Inline = v.(bool)
+			}
+			if v, ok := inline["alias"]; ok {
+				options.ValueDecodingErrors.Alias = v.(string)
+			}
+		}
+	}
+	return options
 }
 
 func GetSourceKafkaEnelopeStruct(v interface{}) KafkaSourceEnvelopeStruct {
@@ -19,6 +51,9 @@ func GetSourceKafkaEnelopeStruct(v interface{}) KafkaSourceEnvelopeStruct {
 	if v, ok := v.([]interface{})[0].(map[string]interface{})["upsert"]; ok {
 		envelope.Upsert = v.(bool)
 	}
+	if v, ok := v.([]interface{})[0].(map[string]interface{})["upsert_options"]; ok {
+		envelope.UpsertOptions = GetUpsertOptionsStruct(v)
+	}
 	if v, ok := v.([]interface{})[0].(map[string]interface{})["debezium"]; ok {
 		envelope.Debezium = v.(bool)
 	}
@@ -199,230 +234,391 @@ func (b *SourceKafkaBuilder) Create() error {
 	q.WriteString(`)`)
 
 	// Format
-	if b.format.Avro != nil {
-		if b.format.Avro.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.format.Avro.SchemaRegistryConnection.DatabaseName, b.format.Avro.SchemaRegistryConnection.SchemaName, b.format.Avro.SchemaRegistryConnection.Name)))
-		}
-		if b.format.Avro.KeyStrategy != "" {
-			q.WriteString(fmt.Sprintf(` KEY STRATEGY %s`, b.format.Avro.KeyStrategy))
-		}
-		if b.format.Avro.ValueStrategy != "" {
-			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, b.format.Avro.ValueStrategy))
-		}
-	}
+	q.WriteString(sourceFormatClause("FORMAT", b.format))
+	q.WriteString(sourceFormatClause("KEY FORMAT", b.keyFormat))
+	q.WriteString(sourceFormatClause("VALUE FORMAT", b.valueFormat))
 
-	if b.format.Protobuf != nil {
-		if b.format.Protobuf.SchemaRegistryConnection.Name != "" && b.format.Protobuf.MessageName != "" {
-			q.WriteString(fmt.Sprintf(` FORMAT PROTOBUF MESSAGE '%s' USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, b.format.Protobuf.MessageName, QualifiedName(b.format.Protobuf.SchemaRegistryConnection.DatabaseName, b.format.Protobuf.SchemaRegistryConnection.SchemaName, b.format.Protobuf.SchemaRegistryConnection.Name)))
-		}
+	// Metadata
+	var i []string
 
-		if b.format.Protobuf.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` FORMAT PROTOBUF USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.format.Protobuf.SchemaRegistryConnection.DatabaseName, b.format.Protobuf.SchemaRegistryConnection.SchemaName, b.format.Protobuf.SchemaRegistryConnection.Name)))
-		}
+	if !b.includeKey && b.keyAlias != "" {
+		return fmt.Errorf("include_key_alias is set but include_key is false")
 	}
 
-	if b.format.Csv != nil {
-		if b.format.Csv.Columns > 0 {
-			q.WriteString(fmt.Sprintf(` FORMAT CSV WITH %d COLUMNS`, b.format.Csv.Columns))
+	if b.includeKey {
+		if b.keyAlias != "" {
+			i = append(i, fmt.Sprintf("KEY AS %s", b.keyAlias))
+		} else {
+			i = append(i, "KEY")
 		}
+	}
 
-		if b.format.Csv.Header != nil {
-			q.WriteString(fmt.Sprintf(` FORMAT CSV WITH HEADER ( %s )`, strings.Join(b.format.Csv.Header, ", ")))
-		}
+	if !b.includeHeaders && b.headersAlias != "" {
+		return fmt.Errorf("include_headers_alias is set but include_headers is false")
+	}
 
-		if b.format.Csv.DelimitedBy != "" {
-			q.WriteString(fmt.Sprintf(` DELIMITER '%s'`, b.format.Csv.DelimitedBy))
+	if b.includeHeaders {
+		if b.headersAlias != "" {
+			i = append(i, fmt.Sprintf("HEADERS AS %s", b.headersAlias))
+		} else {
+			i = append(i, "HEADERS")
 		}
 	}
 
-	if b.format.Bytes {
-		q.WriteString(` FORMAT BYTES`)
+	if !b.includePartition && b.partitionAlias != "" {
+		return fmt.Errorf("include_partition_alias is set but include_partition is false")
 	}
 
-	if b.format.Text {
-		q.WriteString(` FORMAT TEXT`)
+	if b.includePartition {
+		if b.partitionAlias != "" {
+			i = append(i, fmt.Sprintf("PARTITION AS %s", b.partitionAlias))
+		} else {
+			i = append(i, "PARTITION")
+		}
 	}
 
-	if b.format.Json {
-		q.WriteString(` FORMAT JSON`)
+	if !b.includeOffset && b.offsetAlias != "" {
+		return fmt.Errorf("include_offset_alias is set but include_offset is false")
 	}
 
-	if b.keyFormat.Avro != nil {
-		if b.keyFormat.Avro.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.keyFormat.Avro.SchemaRegistryConnection.DatabaseName, b.keyFormat.Avro.SchemaRegistryConnection.SchemaName, b.keyFormat.Avro.SchemaRegistryConnection.Name)))
-		}
-		if b.keyFormat.Avro.KeyStrategy != "" {
-			q.WriteString(fmt.Sprintf(` KEY STRATEGY %s`, b.keyFormat.Avro.KeyStrategy))
-		}
-		if b.keyFormat.Avro.ValueStrategy != "" {
-			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, b.keyFormat.Avro.ValueStrategy))
+	if b.includeOffset {
+		if b.offsetAlias != "" {
+			i = append(i, fmt.Sprintf("OFFSET AS %s", b.offsetAlias))
+		} else {
+			i = append(i, "OFFSET")
 		}
 	}
 
-	if b.keyFormat.Protobuf != nil {
-		if b.keyFormat.Protobuf.SchemaRegistryConnection.Name != "" && b.keyFormat.Protobuf.MessageName != "" {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT PROTOBUF MESSAGE '%s' USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, b.keyFormat.Protobuf.MessageName, QualifiedName(b.keyFormat.Protobuf.SchemaRegistryConnection.DatabaseName, b.keyFormat.Protobuf.SchemaRegistryConnection.SchemaName, b.keyFormat.Protobuf.SchemaRegistryConnection.Name)))
-		}
+	if !b.includeTimestamp && b.timestampAlias != "" {
+		return fmt.Errorf("include_timestamp_alias is set but include_timestamp is false")
+	}
 
-		if b.keyFormat.Protobuf.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT PROTOBUF USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.keyFormat.Protobuf.SchemaRegistryConnection.DatabaseName, b.keyFormat.Protobuf.SchemaRegistryConnection.SchemaName, b.keyFormat.Protobuf.SchemaRegistryConnection.Name)))
+	if b.includeTimestamp {
+		if b.timestampAlias != "" {
+			i = append(i, fmt.Sprintf("TIMESTAMP AS %s", b.timestampAlias))
+		} else {
+			i = append(i, "TIMESTAMP")
 		}
 	}
 
-	if b.keyFormat.Csv != nil {
-		if b.keyFormat.Csv.Columns > 0 {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT CSV WITH %d COLUMNS`, b.keyFormat.Csv.Columns))
-		}
+	if len(i) > 0 {
+		o := strings.Join(i[:], ", ")
+		q.WriteString(fmt.Sprintf(` INCLUDE %s`, o))
+	}
 
-		if b.keyFormat.Csv.Header != nil {
-			q.WriteString(fmt.Sprintf(` KEY FORMAT CSV WITH HEADER ( %s )`, strings.Join(b.keyFormat.Csv.Header, ", ")))
-		}
+	q.WriteString(sourceEnvelopeClause(b.envelope))
 
-		if b.keyFormat.Csv.DelimitedBy != "" {
-			q.WriteString(fmt.Sprintf(` DELIMITER '%s'`, b.keyFormat.Csv.DelimitedBy))
-		}
+	if b.exposeProgress.Name != "" {
+		q.WriteString(fmt.Sprintf(` EXPOSE PROGRESS AS %s`, b.exposeProgress.QualifiedName()))
 	}
 
-	if b.keyFormat.Bytes {
-		q.WriteString(` KEY FORMAT BYTES`)
+	if b.size != "" {
+		q.WriteString(fmt.Sprintf(` WITH (SIZE = %s)`, QuoteString(b.size)))
 	}
 
-	if b.keyFormat.Text {
-		q.WriteString(` KEY FORMAT TEXT`)
-	}
+	q.WriteString(`;`)
+	return b.ddl.exec(q.String())
+}
+
+// sourceFormatClause returns the format specifier of a source or source
+// table, introduced by keyword, e.g. `KEY FORMAT`.
+func sourceFormatClause(keyword string, f SourceFormatSpecStruct) string {
+	q := strings.Builder{}
 
-	if b.valueFormat.Avro != nil {
-		if b.valueFormat.Avro.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.valueFormat.Avro.SchemaRegistryConnection.DatabaseName, b.valueFormat.Avro.SchemaRegistryConnection.SchemaName, b.valueFormat.Avro.SchemaRegistryConnection.Name)))
+	if f.Avro != nil {
+		if f.Avro.SchemaRegistryConnection.Name != "" {
+			q.WriteString(fmt.Sprintf(` %s AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, keyword, QualifiedName(f.Avro.SchemaRegistryConnection.DatabaseName, f.Avro.SchemaRegistryConnection.SchemaName, f.Avro.SchemaRegistryConnection.Name)))
 		}
-		if b.valueFormat.Avro.KeyStrategy != "" {
-			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, b.valueFormat.Avro.KeyStrategy))
+		if f.Avro.KeyStrategy != "" {
+			q.WriteString(fmt.Sprintf(` KEY STRATEGY %s`, f.Avro.KeyStrategy))
 		}
-		if b.valueFormat.Avro.ValueStrategy != "" {
-			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, b.valueFormat.Avro.ValueStrategy))
+		if f.Avro.ValueStrategy != "" {
+			q.WriteString(fmt.Sprintf(` VALUE STRATEGY %s`, f.Avro.ValueStrategy))
 		}
 	}
 
-	if b.valueFormat.Protobuf != nil {
-		if b.valueFormat.Protobuf.SchemaRegistryConnection.Name != "" && b.valueFormat.Protobuf.MessageName != "" {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT PROTOBUF MESSAGE '%s' USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, b.valueFormat.Protobuf.MessageName, QualifiedName(b.valueFormat.Protobuf.SchemaRegistryConnection.DatabaseName, b.valueFormat.Protobuf.SchemaRegistryConnection.SchemaName, b.valueFormat.Protobuf.SchemaRegistryConnection.Name)))
+	if f.Protobuf != nil {
+		if f.Protobuf.SchemaRegistryConnection.Name != "" && f.Protobuf.MessageName != "" {
+			q.WriteString(fmt.Sprintf(` %s PROTOBUF MESSAGE '%s' USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, keyword, f.Protobuf.MessageName, QualifiedName(f.Protobuf.SchemaRegistryConnection.DatabaseName, f.Protobuf.SchemaRegistryConnection.SchemaName, f.Protobuf.SchemaRegistryConnection.Name)))
 		}
 
-		if b.valueFormat.Protobuf.SchemaRegistryConnection.Name != "" {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT PROTOBUF USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, QualifiedName(b.valueFormat.Protobuf.SchemaRegistryConnection.DatabaseName, b.valueFormat.Protobuf.SchemaRegistryConnection.SchemaName, b.valueFormat.Protobuf.SchemaRegistryConnection.Name)))
+		if f.Protobuf.SchemaRegistryConnection.Name != "" {
+			q.WriteString(fmt.Sprintf(` %s PROTOBUF USING CONFLUENT SCHEMA REGISTRY CONNECTION %s`, keyword, QualifiedName(f.Protobuf.SchemaRegistryConnection.DatabaseName, f.Protobuf.SchemaRegistryConnection.SchemaName, f.Protobuf.SchemaRegistryConnection.Name)))
 		}
 	}
 
-	if b.valueFormat.Csv != nil {
-		if b.valueFormat.Csv.Columns > 0 {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT CSV WITH %d COLUMNS`, b.valueFormat.Csv.Columns))
+	if f.Csv != nil {
+		if f.Csv.Columns > 0 {
+			q.WriteString(fmt.Sprintf(` %s CSV WITH %d COLUMNS`, keyword, f.Csv.Columns))
 		}
 
-		if b.valueFormat.Csv.Header != nil {
-			q.WriteString(fmt.Sprintf(` VALUE FORMAT CSV WITH HEADER ( %s )`, strings.Join(b.valueFormat.Csv.Header, ", ")))
+		if f.Csv.Header != nil {
+			q.WriteString(fmt.Sprintf(` %s CSV WITH HEADER ( %s )`, keyword, strings.Join(f.Csv.Header, ", ")))
 		}
 
-		if b.valueFormat.Csv.DelimitedBy != "" {
-			q.WriteString(fmt.Sprintf(` DELIMITER '%s'`, b.valueFormat.Csv.DelimitedBy))
+		if f.Csv.DelimitedBy != "" {
+			q.WriteString(fmt.Sprintf(` DELIMITER '%s'`, f.Csv.DelimitedBy))
 		}
 	}
 
-	if b.valueFormat.Bytes {
-		q.WriteString(` VALUE FORMAT BYTES`)
+	if f.Bytes {
+		q.WriteString(fmt.Sprintf(` %s BYTES`, keyword))
 	}
 
-	if b.valueFormat.Text {
-		q.WriteString(` VALUE FORMAT TEXT`)
+	if f.Text {
+		q.WriteString(fmt.Sprintf(` %s TEXT`, keyword))
 	}
 
-	// Metadata
-	var i []string
+	if f.Json {
+		q.WriteString(fmt.Sprintf(` %s JSON`, keyword))
+	}
 
-	if !b.includeKey && b.keyAlias != "" {
-		return fmt.Errorf("include_key_alias is set but include_key is false")
+	return q.String()
+}
+
+// sourceEnvelopeClause returns the `ENVELOPE` of a source or source table.
+func sourceEnvelopeClause(e KafkaSourceEnvelopeStruct) string {
+	q := strings.Builder{}
+
+	if e.Debezium {
+		q.WriteString(` ENVELOPE DEBEZIUM`)
 	}
 
-	if b.includeKey {
-		if b.keyAlias != "" {
-			i = append(i, fmt.Sprintf("KEY AS %s", b.keyAlias))
-		} else {
-			i = append(i, "KEY")
+	if e.Upsert {
+		q.WriteString(` ENVELOPE UPSERT`)
+
+		vde := e.UpsertOptions.ValueDecodingErrors
+		if vde.Inline && vde.Alias != "" {
+			q.WriteString(fmt.Sprintf(` (VALUE DECODING ERRORS = (INLINE AS %s))`, QuoteIdentifier(vde.Alias)))
+		} else if vde.Inline {
+			q.WriteString(` (VALUE DECODING ERRORS = INLINE)`)
 		}
 	}
 
-	if !b.includeHeaders && b.headersAlias != "" {
-		return fmt.Errorf("include_headers_alias is set but include_headers is false")
+	if e.None {
+		q.WriteString(` ENVELOPE NONE`)
 	}
 
-	if b.includeHeaders {
-		if b.headersAlias != "" {
-			i = append(i, fmt.Sprintf("HEADERS AS %s", b.headersAlias))
-		} else {
-			i = append(i, "HEADERS")
-		}
-	}
+	return q.String()
+}
 
-	if !b.includePartition && b.partitionAlias != "" {
-		return fmt.Errorf("include_partition_alias is set but include_partition is false")
+// Configuration of an existing Kafka source, as reported by the catalog
+type SourceKafkaDefinition struct {
+	KafkaConnection  IdentifierSchemaStruct
+	Topic            string
+	IncludeKey       bool
+	IncludeHeaders   bool
+	IncludePartition bool
+	IncludeOffset    bool
+	IncludeTimestamp bool
+	KeyAlias         string
+	HeadersAlias     string
+	PartitionAlias   string
+	OffsetAlias      string
+	TimestampAlias   string
+	Format           SourceFormatSpecStruct
+	KeyFormat        SourceFormatSpecStruct
+	ValueFormat      SourceFormatSpecStruct
+	Envelope         KafkaSourceEnvelopeStruct
+	StartOffset      []int
+	StartTimestamp   int
+	ExposeProgress   IdentifierSchemaStruct
+}
+
+func ParseSourceKafka(createSql string) (SourceKafkaDefinition, error) {
+	var s SourceKafkaDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return s, err
 	}
 
-	if b.includePartition {
-		if b.partitionAlias != "" {
-			i = append(i, fmt.Sprintf("PARTITION AS %s", b.partitionAlias))
-		} else {
-			i = append(i, "PARTITION")
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("FROM", "KAFKA", "CONNECTION"):
+			if s.KafkaConnection, err = p.parseQualifiedName(); err != nil {
+				return s, err
+			}
+			if !p.peekSymbol("(") {
+				continue
+			}
+			options, err := p.parseOptionList()
+			if err != nil {
+				return s, err
+			}
+			for _, o := range options {
+				switch o.Name {
+				case "TOPIC":
+					s.Topic = o.optionValue()
+				case "START OFFSET":
+					if s.StartOffset, err = o.optionInts(); err != nil {
+						return s, err
+					}
+				case "START TIMESTAMP":
+					if s.StartTimestamp, err = strconv.Atoi(o.optionValue()); err != nil {
+						return s, err
+					}
+				}
+			}
+		case p.acceptKeyword("KEY", "FORMAT"):
+			if s.KeyFormat, err = p.parseFormatSpec(); err != nil {
+				return s, err
+			}
+		case p.acceptKeyword("VALUE", "FORMAT"):
+			if s.ValueFormat, err = p.parseFormatSpec(); err != nil {
+				return s, err
+			}
+		case p.acceptKeyword("FORMAT"):
+			if s.Format, err = p.parseFormatSpec(); err != nil {
+				return s, err
+			}
+		case p.acceptKeyword("INCLUDE"):
+			if err := parseKafkaInclude(p, &s); err != nil {
+				return s, err
+			}
+		case p.acceptKeyword("ENVELOPE"):
+			if s.Envelope, err = parseKafkaEnvelope(p); err != nil {
+				return s, err
+			}
+		case p.acceptKeyword("EXPOSE", "PROGRESS", "AS"):
+			if s.ExposeProgress, err = p.parseQualifiedName(); err != nil {
+				return s, err
+			}
+		default:
+			p.skip()
 		}
 	}
 
-	if !b.includeOffset && b.offsetAlias != "" {
-		return fmt.Errorf("include_offset_alias is set but include_offset is false")
-	}
+	return s, nil
+}
 
-	if b.includeOffset {
-		if b.offsetAlias != "" {
-			i = append(i, fmt.Sprintf("OFFSET AS %s", b.offsetAlias))
-		} else {
-			i = append(i, "OFFSET")
+func parseKafkaInclude(p *sqlParser, s *SourceKafkaDefinition) error {
+	alias := func() (string, error) {
+		if p.acceptKeyword("AS") {
+			return p.parseIdentifier()
+		}
+		return "", nil
+	}
+
+	for {
+		var err error
+		switch {
+		case p.acceptKeyword("KEY"):
+			s.IncludeKey = true
+			s.KeyAlias, err = alias()
+		case p.acceptKeyword("HEADERS"):
+			s.IncludeHeaders = true
+			s.HeadersAlias, err = alias()
+		case p.acceptKeyword("PARTITION"):
+			s.IncludePartition = true
+			s.PartitionAlias, err = alias()
+		case p.acceptKeyword("OFFSET"):
+			s.IncludeOffset = true
+			s.OffsetAlias, err = alias()
+		case p.acceptKeyword("TIMESTAMP"):
+			s.IncludeTimestamp = true
+			s.TimestampAlias, err = alias()
+		case p.acceptKeyword("HEADER"):
+			// Individual headers are not managed by the provider
+			if _, err = p.parseString(); err == nil {
+				_, err = alias()
+				p.acceptKeyword("BYTES")
+			}
+		default:
+			return p.unexpected("include option")
+		}
+		if err != nil {
+			return err
 		}
-	}
 
-	if !b.includeTimestamp && b.timestampAlias != "" {
-		return fmt.Errorf("include_timestamp_alias is set but include_timestamp is false")
+		if !p.acceptSymbol(",") {
+			return nil
+		}
 	}
+}
 
-	if b.includeTimestamp {
-		if b.timestampAlias != "" {
-			i = append(i, fmt.Sprintf("TIMESTAMP AS %s", b.timestampAlias))
-		} else {
-			i = append(i, "TIMESTAMP")
+func parseKafkaEnvelope(p *sqlParser) (KafkaSourceEnvelopeStruct, error) {
+	var e KafkaSourceEnvelopeStruct
+
+	switch {
+	case p.acceptKeyword("NONE"):
+		e.None = true
+	case p.acceptKeyword("DEBEZIUM"):
+		e.Debezium = true
+	case p.acceptKeyword("UPSERT"):
+		e.Upsert = true
+		if !p.peekSymbol("(") {
+			return e, nil
+		}
+		options, err := p.parseOptionList()
+		if err != nil {
+			return e, err
 		}
+		for _, o := range options {
+			if o.Name != "VALUE DECODING ERRORS" {
+				continue
+			}
+			for i, t := range o.Value {
+				if t.kind == sqlWord && strings.EqualFold(t.value, "INLINE") {
+					e.UpsertOptions.ValueDecodingErrors.Inline = true
+				}
+				if t.kind == sqlWord && strings.EqualFold(t.value, "AS") && i+1 < len(o.Value) {
+					e.UpsertOptions.ValueDecodingErrors.Alias = o.Value[i+1].value
+				}
+			}
+		}
+	default:
+		return e, p.unexpected("envelope")
 	}
 
-	if len(i) > 0 {
-		o := strings.Join(i[:], ", ")
-		q.WriteString(fmt.Sprintf(` INCLUDE %s`, o))
-	}
+	return e, nil
+}
 
-	if b.envelope.Debezium {
-		q.WriteString(` ENVELOPE DEBEZIUM`)
-	}
+type SourceKafkaParams struct {
+	SourceId     sql.NullString `db:"id"`
+	SourceName   sql.NullString `db:"name"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	Topic        sql.NullString `db:"topic"`
+}
 
-	if b.envelope.Upsert {
-		q.WriteString(` ENVELOPE UPSERT`)
+var sourceKafkaQuery = NewBaseQuery(`
+	SELECT
+		mz_sources.id,
+		mz_sources.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_kafka_sources.topic
+	FROM mz_sources
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_kafka_sources
+		ON mz_sources.id = mz_kafka_sources.id`)
+
+func ScanSourceKafka(conn *sqlx.DB, id string) (SourceKafkaDefinition, error) {
+	q := sourceKafkaQuery.QueryPredicate(map[string]string{"mz_sources.id": id})
+
+	var c SourceKafkaParams
+	if err := conn.Get(&c, q); err != nil {
+		return SourceKafkaDefinition{}, err
 	}
 
-	if b.envelope.None {
-		q.WriteString(` ENVELOPE NONE`)
+	qn := QualifiedName(c.DatabaseName.String, c.SchemaName.String, c.SourceName.String)
+	createSql, err := ShowCreate(conn, BaseSource, qn)
+	if err != nil {
+		return SourceKafkaDefinition{}, err
 	}
 
-	if b.exposeProgress.Name != "" {
-		q.WriteString(fmt.Sprintf(` EXPOSE PROGRESS AS %s`, b.exposeProgress.QualifiedName()))
+	s, err := ParseSourceKafka(createSql)
+	if err != nil {
+		return s, fmt.Errorf("unable to parse definition of source %s: %w", qn, err)
 	}
 
-	if b.size != "" {
-		q.WriteString(fmt.Sprintf(` WITH (SIZE = %s)`, QuoteString(b.size)))
+	if c.Topic.Valid {
+		s.Topic = c.Topic.String
 	}
 
-	q.WriteString(`;`)
-	return b.ddl.exec(q.String())
+	return s, nil
 }
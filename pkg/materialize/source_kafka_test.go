@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceSourceKafkaCreate(t *testing.T) {
@@ -39,3 +40,108 @@ func TestResourceSourceKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceSourceKafkaUpsertValueDecodingErrorsCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster"
+			FROM KAFKA CONNECTION "database"."schema"."kafka_connection"
+			\(TOPIC 'events'\) FORMAT AVRO
+			USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
+			INCLUDE KEY AS message_key ENVELOPE UPSERT \(VALUE DECODING ERRORS = INLINE\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSourceKafkaBuilder(db, o)
+		b.ClusterName("cluster")
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", DatabaseName: "database", SchemaName: "schema"})
+		b.Topic("events")
+		b.Format(SourceFormatSpecStruct{Avro: &AvroFormatSpec{SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr_connection", DatabaseName: "database", SchemaName: "schema"}}})
+		b.IncludeKeyAlias("message_key")
+		b.Envelope(KafkaSourceEnvelopeStruct{Upsert: true, UpsertOptions: UpsertOptionsStruct{ValueDecodingErrors: ValueDecodingErrorsStruct{Inline: true}}})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourceKafkaUpsertValueDecodingErrorsAliasCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster"
+			FROM KAFKA CONNECTION "database"."schema"."kafka_connection"
+			\(TOPIC 'events'\) FORMAT JSON
+			ENVELOPE UPSERT \(VALUE DECODING ERRORS = \(INLINE AS "decoding_error"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSourceKafkaBuilder(db, o)
+		b.ClusterName("cluster")
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", DatabaseName: "database", SchemaName: "schema"})
+		b.Topic("events")
+		b.Format(SourceFormatSpecStruct{Json: true})
+		b.Envelope(KafkaSourceEnvelopeStruct{Upsert: true, UpsertOptions: UpsertOptionsStruct{ValueDecodingErrors: ValueDecodingErrorsStruct{Inline: true, Alias: "decoding_error"}}})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourceKafkaAvroStrategyCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster"
+			FROM KAFKA CONNECTION "database"."schema"."kafka_connection"
+			\(TOPIC 'events'\)
+			KEY FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection" KEY STRATEGY LATEST
+			VALUE FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection" KEY STRATEGY LATEST VALUE STRATEGY LATEST
+			ENVELOPE UPSERT;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		csr := IdentifierSchemaStruct{Name: "csr_connection", DatabaseName: "database", SchemaName: "schema"}
+		o := MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
+		b := NewSourceKafkaBuilder(db, o)
+		b.ClusterName("cluster")
+		b.KafkaConnection(IdentifierSchemaStruct{Name: "kafka_connection", DatabaseName: "database", SchemaName: "schema"})
+		b.Topic("events")
+		b.KeyFormat(SourceFormatSpecStruct{Avro: &AvroFormatSpec{SchemaRegistryConnection: csr, KeyStrategy: "LATEST"}})
+		b.ValueFormat(SourceFormatSpecStruct{Avro: &AvroFormatSpec{SchemaRegistryConnection: csr, KeyStrategy: "LATEST", ValueStrategy: "LATEST"}})
+		b.Envelope(KafkaSourceEnvelopeStruct{Upsert: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestParseSourceKafka(t *testing.T) {
+	r := require.New(t)
+	s, err := ParseSourceKafka(`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM KAFKA CONNECTION "database"."schema"."kafka_connection" (START OFFSET = (1, 2, 3), TOPIC = 'events') KEY FORMAT TEXT VALUE FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection" SEED VALUE SCHEMA '{"type": "long"}' INCLUDE KEY, PARTITION AS "p", TIMESTAMP ENVELOPE UPSERT (VALUE DECODING ERRORS = (INLINE AS "decode_error")) EXPOSE PROGRESS AS "database"."schema"."progress"`)
+	r.NoError(err)
+
+	r.Equal(IdentifierSchemaStruct{Name: "kafka_connection", SchemaName: "schema", DatabaseName: "database"}, s.KafkaConnection)
+	r.Equal("events", s.Topic)
+	r.Equal([]int{1, 2, 3}, s.StartOffset)
+	r.True(s.KeyFormat.Text)
+	r.Equal("csr_connection", s.ValueFormat.Avro.SchemaRegistryConnection.Name)
+	r.True(s.IncludeKey)
+	r.Equal("", s.KeyAlias)
+	r.True(s.IncludePartition)
+	r.Equal("p", s.PartitionAlias)
+	r.True(s.IncludeTimestamp)
+	r.False(s.IncludeHeaders)
+	r.Equal(KafkaSourceEnvelopeStruct{Upsert: true, UpsertOptions: UpsertOptionsStruct{ValueDecodingErrors: ValueDecodingErrorsStruct{Inline: true, Alias: "decode_error"}}}, s.Envelope)
+	r.Equal("progress", s.ExposeProgress.Name)
+}
+
+func TestParseSourceKafkaStartTimestamp(t *testing.T) {
+	r := require.New(t)
+	s, err := ParseSourceKafka(`CREATE SOURCE "database"."schema"."source" FROM KAFKA CONNECTION "database"."schema"."kafka_connection" (TOPIC = 'events', START TIMESTAMP = -1000) FORMAT JSON ENVELOPE NONE`)
+	r.NoError(err)
+
+	r.Equal(-1000, s.StartTimestamp)
+	r.True(s.Format.Json)
+	r.True(s.Envelope.None)
+}
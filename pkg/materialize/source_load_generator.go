@@ -2,6 +2,7 @@ package materialize
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -84,6 +85,62 @@ func GetTPCHOptionsStruct(v interface{}) TPCHOptions {
 	return o
 }
 
+type KeyValueOptions struct {
+	Keys           int
+	SnapshotRounds int
+	ValueSize      int
+	Seed           int
+	Partitions     int
+	BatchSize      int
+	TickInterval   string
+}
+
+func GetKeyValueOptionsStruct(v interface{}) KeyValueOptions {
+	var o KeyValueOptions
+	u := v.([]interface{})[0].(map[string]interface{})
+	if v, ok := u["keys"]; ok {
+		o.Keys = v.(int)
+	}
+
+	if v, ok := u["snapshot_rounds"]; ok {
+		o.SnapshotRounds = v.(int)
+	}
+
+	if v, ok := u["value_size"]; ok {
+		o.ValueSize = v.(int)
+	}
+
+	if v, ok := u["seed"]; ok {
+		o.Seed = v.(int)
+	}
+
+	if v, ok := u["partitions"]; ok {
+		o.Partitions = v.(int)
+	}
+
+	if v, ok := u["batch_size"]; ok {
+		o.BatchSize = v.(int)
+	}
+
+	if v, ok := u["tick_interval"]; ok {
+		o.TickInterval = v.(string)
+	}
+	return o
+}
+
+type ClockOptions struct {
+	TickInterval string
+}
+
+func GetClockOptionsStruct(v interface{}) ClockOptions {
+	var o ClockOptions
+	u := v.([]interface{})[0].(map[string]interface{})
+	if v, ok := u["tick_interval"]; ok {
+		o.TickInterval = v.(string)
+	}
+	return o
+}
+
 type SourceLoadgenBuilder struct {
 	Source
 	clusterName       string
@@ -93,6 +150,9 @@ type SourceLoadgenBuilder struct {
 	auctionOptions    AuctionOptions
 	marketingOptions  MarketingOptions
 	tpchOptions       TPCHOptions
+	keyValueOptions   KeyValueOptions
+	clockOptions      ClockOptions
+	table             []TableStruct
 	exposeProgress    IdentifierSchemaStruct
 }
 
@@ -143,6 +203,23 @@ func (b *SourceLoadgenBuilder) TPCHOptions(t TPCHOptions) *SourceLoadgenBuilder
 	return b
 }
 
+func (b *SourceLoadgenBuilder) KeyValueOptions(k KeyValueOptions) *SourceLoadgenBuilder {
+	b.keyValueOptions = k
+	return b
+}
+
+func (b *SourceLoadgenBuilder) ClockOptions(c ClockOptions) *SourceLoadgenBuilder {
+	b.clockOptions = c
+	return b
+}
+
+// Table limits the subsources of a multi-output load generator to the given
+// tables.
+func (b *SourceLoadgenBuilder) Table(t []TableStruct) *SourceLoadgenBuilder {
+	b.table = t
+	return b
+}
+
 func (b *SourceLoadgenBuilder) Create() error {
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE SOURCE %s`, b.QualifiedName()))
@@ -156,7 +233,18 @@ func (b *SourceLoadgenBuilder) Create() error {
 	// Optional Parameters
 	var p []string
 
-	for _, t := range []string{b.counterOptions.TickInterval, b.auctionOptions.TickInterval, b.marketingOptions.TickInterval, b.tpchOptions.TickInterval} {
+	if k := b.keyValueOptions; k != (KeyValueOptions{}) {
+		p = append(p,
+			fmt.Sprintf(`KEYS %d`, k.Keys),
+			fmt.Sprintf(`SNAPSHOT ROUNDS %d`, k.SnapshotRounds),
+			fmt.Sprintf(`VALUE SIZE %d`, k.ValueSize),
+			fmt.Sprintf(`SEED %d`, k.Seed),
+			fmt.Sprintf(`PARTITIONS %d`, k.Partitions),
+			fmt.Sprintf(`BATCH SIZE %d`, k.BatchSize),
+		)
+	}
+
+	for _, t := range []string{b.counterOptions.TickInterval, b.auctionOptions.TickInterval, b.marketingOptions.TickInterval, b.tpchOptions.TickInterval, b.keyValueOptions.TickInterval, b.clockOptions.TickInterval} {
 		if t != "" {
 			p = append(p, fmt.Sprintf(`TICK INTERVAL %s`, QuoteString(t)))
 		}
@@ -179,7 +267,19 @@ func (b *SourceLoadgenBuilder) Create() error {
 	}
 
 	// Include for multi-output sources
-	if b.loadGeneratorType == "AUCTION" || b.loadGeneratorType == "MARKETING" || b.loadGeneratorType == "TPCH" {
+	if len(b.table) > 0 {
+		q.WriteString(` FOR TABLES (`)
+		for i, t := range b.table {
+			if t.Alias == "" {
+				t.Alias = t.Name
+			}
+			q.WriteString(fmt.Sprintf(`%s AS %s`, t.Name, t.Alias))
+			if i < len(b.table)-1 {
+				q.WriteString(`, `)
+			}
+		}
+		q.WriteString(`)`)
+	} else if b.loadGeneratorType == "AUCTION" || b.loadGeneratorType == "MARKETING" || b.loadGeneratorType == "TPCH" {
 		q.WriteString(` FOR ALL TABLES`)
 	}
 
@@ -195,3 +295,145 @@ func (b *SourceLoadgenBuilder) Create() error {
 	q.WriteString(`;`)
 	return b.ddl.exec(q.String())
 }
+
+type SourceLoadgenDefinition struct {
+	LoadGeneratorType string
+	CounterOptions    CounterOptions
+	AuctionOptions    AuctionOptions
+	MarketingOptions  MarketingOptions
+	TPCHOptions       TPCHOptions
+	KeyValueOptions   KeyValueOptions
+	ClockOptions      ClockOptions
+	Tables            []TableStruct
+	ExposeProgress    IdentifierSchemaStruct
+}
+
+func ParseSourceLoadgen(createSql string) (SourceLoadgenDefinition, error) {
+	var s SourceLoadgenDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return s, err
+	}
+
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("FROM", "LOAD", "GENERATOR"):
+			t, err := p.parseIdentifier()
+			if err != nil {
+				return s, err
+			}
+			s.LoadGeneratorType = strings.ToUpper(t)
+			if s.LoadGeneratorType == "KEY" && p.acceptKeyword("VALUE") {
+				s.LoadGeneratorType = "KEY VALUE"
+			}
+			if !p.peekSymbol("(") {
+				continue
+			}
+			options, err := p.parseOptionList()
+			if err != nil {
+				return s, err
+			}
+			var tickInterval string
+			var scaleFactor float64
+			var maxCardinality int
+			var keyValue KeyValueOptions
+			for _, o := range options {
+				switch o.Name {
+				case "TICK INTERVAL":
+					tickInterval = o.optionValue()
+				case "SCALE FACTOR":
+					if scaleFactor, err = strconv.ParseFloat(o.optionValue(), 64); err != nil {
+						return s, err
+					}
+				case "MAX CARDINALITY":
+					if maxCardinality, err = o.optionInt(); err != nil {
+						return s, err
+					}
+				case "KEYS":
+					if keyValue.Keys, err = o.optionInt(); err != nil {
+						return s, err
+					}
+				case "SNAPSHOT ROUNDS":
+					if keyValue.SnapshotRounds, err = o.optionInt(); err != nil {
+						return s, err
+					}
+				case "VALUE SIZE":
+					if keyValue.ValueSize, err = o.optionInt(); err != nil {
+						return s, err
+					}
+				case "SEED":
+					if keyValue.Seed, err = o.optionInt(); err != nil {
+						return s, err
+					}
+				case "PARTITIONS":
+					if keyValue.Partitions, err = o.optionInt(); err != nil {
+						return s, err
+					}
+				case "BATCH SIZE":
+					if keyValue.BatchSize, err = o.optionInt(); err != nil {
+						return s, err
+					}
+				}
+			}
+			switch s.LoadGeneratorType {
+			case "COUNTER":
+				s.CounterOptions = CounterOptions{TickInterval: tickInterval, ScaleFactor: scaleFactor, MaxCardinality: maxCardinality}
+			case "AUCTION":
+				s.AuctionOptions = AuctionOptions{TickInterval: tickInterval, ScaleFactor: scaleFactor}
+			case "MARKETING":
+				s.MarketingOptions = MarketingOptions{TickInterval: tickInterval, ScaleFactor: scaleFactor}
+			case "TPCH":
+				s.TPCHOptions = TPCHOptions{TickInterval: tickInterval, ScaleFactor: scaleFactor}
+			case "KEY VALUE":
+				keyValue.TickInterval = tickInterval
+				s.KeyValueOptions = keyValue
+			case "CLOCK":
+				s.ClockOptions = ClockOptions{TickInterval: tickInterval}
+			}
+		case p.acceptKeyword("FOR", "TABLES"):
+			if s.Tables, err = p.parseTableList(); err != nil {
+				return s, err
+			}
+		case p.acceptKeyword("EXPOSE", "PROGRESS", "AS"):
+			if s.ExposeProgress, err = p.parseQualifiedName(); err != nil {
+				return s, err
+			}
+		default:
+			p.skip()
+		}
+	}
+
+	return s, nil
+}
+
+// parseTableList reads a parenthesized list of `<table> AS <subsource>`
+// references. Only the last part of the names is kept, and the alias is left
+// empty when the subsource is named after the table.
+func (p *sqlParser) parseTableList() ([]TableStruct, error) {
+	var tables []TableStruct
+	if err := p.expectSymbol("("); err != nil {
+		return nil, err
+	}
+	for !p.acceptSymbol(")") {
+		n, err := p.parseQualifiedName()
+		if err != nil {
+			return nil, err
+		}
+		t := TableStruct{Name: n.Name}
+		if p.acceptKeyword("AS") {
+			a, err := p.parseQualifiedName()
+			if err != nil {
+				return nil, err
+			}
+			if a.Name != n.Name {
+				t.Alias = a.Name
+			}
+		}
+		tables = append(tables, t)
+		if !p.acceptSymbol(",") && !p.peekSymbol(")") {
+			return nil, p.unexpected("',' or ')'")
+		}
+	}
+	return tables, nil
+}
@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 var sourceLoadgen = MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
@@ -106,3 +107,156 @@ func TestSourceLoadgenTPCHParamsCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestSourceLoadgenKeyValueCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			IN CLUSTER "cluster"
+			FROM LOAD GENERATOR KEY VALUE
+			\(KEYS 16, SNAPSHOT ROUNDS 3, VALUE SIZE 10, SEED 123, PARTITIONS 4, BATCH SIZE 2, TICK INTERVAL '1s'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b.ClusterName("cluster")
+		b.LoadGeneratorType("KEY VALUE")
+		b.KeyValueOptions(KeyValueOptions{
+			Keys:           16,
+			SnapshotRounds: 3,
+			ValueSize:      10,
+			Seed:           123,
+			Partitions:     4,
+			BatchSize:      2,
+			TickInterval:   "1s",
+		})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceLoadgenClockCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			IN CLUSTER "cluster"
+			FROM LOAD GENERATOR CLOCK
+			\(TICK INTERVAL '1s'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b.ClusterName("cluster")
+		b.LoadGeneratorType("CLOCK")
+		b.ClockOptions(ClockOptions{TickInterval: "1s"})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceLoadgenAuctionTablesCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			IN CLUSTER "cluster"
+			FROM LOAD GENERATOR AUCTION
+			FOR TABLES \(bids AS auction_bids, users AS users\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceLoadgenBuilder(db, sourceLoadgen)
+		b.ClusterName("cluster")
+		b.LoadGeneratorType("AUCTION")
+		b.Table([]TableStruct{
+			{Name: "bids", Alias: "auction_bids"},
+			{Name: "users"},
+		})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceLoadgenRoundTrip(t *testing.T) {
+	r := require.New(t)
+	progress := IdentifierSchemaStruct{Name: "progress", SchemaName: "schema", DatabaseName: "database"}
+
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewSourceLoadgenBuilder(db, sourceLoadgen).
+			ClusterName("cluster").
+			LoadGeneratorType("COUNTER").
+			CounterOptions(CounterOptions{TickInterval: "1s", ScaleFactor: 0.01, MaxCardinality: 8}).
+			ExposeProgress(progress).
+			Create()
+	})
+	l, err := ParseSourceLoadgen(s)
+	r.NoError(err)
+	r.Equal(SourceLoadgenDefinition{
+		LoadGeneratorType: "COUNTER",
+		CounterOptions:    CounterOptions{TickInterval: "1s", ScaleFactor: 0.01, MaxCardinality: 8},
+		ExposeProgress:    progress,
+	}, l)
+
+	s = createStatement(t, func(db *sqlx.DB) error {
+		return NewSourceLoadgenBuilder(db, sourceLoadgen).
+			LoadGeneratorType("TPCH").
+			TPCHOptions(TPCHOptions{TickInterval: "1m", ScaleFactor: 0.5}).
+			Create()
+	})
+	l, err = ParseSourceLoadgen(s)
+	r.NoError(err)
+	r.Equal(SourceLoadgenDefinition{
+		LoadGeneratorType: "TPCH",
+		TPCHOptions:       TPCHOptions{TickInterval: "1m", ScaleFactor: 0.5},
+	}, l)
+
+	keyValue := KeyValueOptions{Keys: 16, SnapshotRounds: 3, ValueSize: 10, Seed: 123, Partitions: 4, BatchSize: 2, TickInterval: "1s"}
+	s = createStatement(t, func(db *sqlx.DB) error {
+		return NewSourceLoadgenBuilder(db, sourceLoadgen).
+			LoadGeneratorType("KEY VALUE").
+			KeyValueOptions(keyValue).
+			Create()
+	})
+	l, err = ParseSourceLoadgen(s)
+	r.NoError(err)
+	r.Equal(SourceLoadgenDefinition{
+		LoadGeneratorType: "KEY VALUE",
+		KeyValueOptions:   keyValue,
+	}, l)
+
+	s = createStatement(t, func(db *sqlx.DB) error {
+		return NewSourceLoadgenBuilder(db, sourceLoadgen).
+			LoadGeneratorType("CLOCK").
+			ClockOptions(ClockOptions{TickInterval: "5s"}).
+			Create()
+	})
+	l, err = ParseSourceLoadgen(s)
+	r.NoError(err)
+	r.Equal(SourceLoadgenDefinition{
+		LoadGeneratorType: "CLOCK",
+		ClockOptions:      ClockOptions{TickInterval: "5s"},
+	}, l)
+
+	tables := []TableStruct{{Name: "bids", Alias: "auction_bids"}, {Name: "users"}}
+	s = createStatement(t, func(db *sqlx.DB) error {
+		return NewSourceLoadgenBuilder(db, sourceLoadgen).
+			LoadGeneratorType("AUCTION").
+			Table(tables).
+			Create()
+	})
+	l, err = ParseSourceLoadgen(s)
+	r.NoError(err)
+	r.Equal(SourceLoadgenDefinition{
+		LoadGeneratorType: "AUCTION",
+		Tables:            tables,
+	}, l)
+}
+
+func TestParseSourceLoadgenTablesNormalized(t *testing.T) {
+	r := require.New(t)
+	l, err := ParseSourceLoadgen(`CREATE SOURCE "database"."schema"."source" FROM LOAD GENERATOR AUCTION FOR TABLES ("mz_load_generators"."auction"."bids" AS "database"."schema"."auction_bids", "mz_load_generators"."auction"."users" AS "database"."schema"."users")`)
+	r.NoError(err)
+	r.Equal([]TableStruct{{Name: "bids", Alias: "auction_bids"}, {Name: "users"}}, l.Tables)
+}
@@ -1,6 +1,7 @@
 package materialize
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -16,13 +17,15 @@ type SourcePostgresBuilder struct {
 	textColumns        []string
 	table              []TableStruct
 	schema             []string
+	allTables          bool
 	exposeProgress     IdentifierSchemaStruct
 }
 
 func NewSourcePostgresBuilder(conn *sqlx.DB, obj MaterializeObject) *SourcePostgresBuilder {
 	b := Builder{conn, BaseSource}
 	return &SourcePostgresBuilder{
-		Source: Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
+		Source:    Source{b, obj.Name, obj.SchemaName, obj.DatabaseName},
+		allTables: true,
 	}
 }
 
@@ -61,6 +64,14 @@ func (b *SourcePostgresBuilder) Schema(t []string) *SourcePostgresBuilder {
 	return b
 }
 
+// AllTables creates subsources for every table of the publication when no
+// table or schema is given. Without it the source is created without
+// subsources, for tables created with CREATE TABLE ... FROM SOURCE.
+func (b *SourcePostgresBuilder) AllTables(a bool) *SourcePostgresBuilder {
+	b.allTables = a
+	return b
+}
+
 func (b *SourcePostgresBuilder) ExposeProgress(e IdentifierSchemaStruct) *SourcePostgresBuilder {
 	b.exposeProgress = e
 	return b
@@ -101,7 +112,7 @@ func (b *SourcePostgresBuilder) Create() error {
 	} else if len(b.schema) > 0 {
 		s := strings.Join(b.schema, ", ")
 		q.WriteString(fmt.Sprintf(` FOR SCHEMAS (%s)`, s))
-	} else {
+	} else if b.allTables {
 		q.WriteString(` FOR ALL TABLES`)
 	}
 
@@ -157,3 +168,161 @@ func (b *Source) DropSubsource(subsources []TableStruct) error {
 	q := fmt.Sprintf(`ALTER SOURCE %s DROP SUBSOURCE %s;`, b.QualifiedName(), s)
 	return b.ddl.exec(q)
 }
+
+// Configuration of an existing Postgres source, as reported by the catalog
+type SourcePostgresDefinition struct {
+	PostgresConnection IdentifierSchemaStruct
+	Publication        string
+	ReplicationSlot    string
+	ExposeProgress     IdentifierSchemaStruct
+}
+
+func ParseSourcePostgres(createSql string) (SourcePostgresDefinition, error) {
+	var s SourcePostgresDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return s, err
+	}
+
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("FROM", "POSTGRES", "CONNECTION"):
+			if s.PostgresConnection, err = p.parseQualifiedName(); err != nil {
+				return s, err
+			}
+			if !p.peekSymbol("(") {
+				continue
+			}
+			options, err := p.parseOptionList()
+			if err != nil {
+				return s, err
+			}
+			for _, o := range options {
+				if o.Name == "PUBLICATION" {
+					s.Publication = o.optionValue()
+				}
+			}
+		case p.acceptKeyword("EXPOSE", "PROGRESS", "AS"):
+			if s.ExposeProgress, err = p.parseQualifiedName(); err != nil {
+				return s, err
+			}
+		default:
+			p.skip()
+		}
+	}
+
+	return s, nil
+}
+
+type SourcePostgresParams struct {
+	SourceId        sql.NullString `db:"id"`
+	SourceName      sql.NullString `db:"name"`
+	SchemaName      sql.NullString `db:"schema_name"`
+	DatabaseName    sql.NullString `db:"database_name"`
+	ReplicationSlot sql.NullString `db:"replication_slot"`
+}
+
+var sourcePostgresQuery = NewBaseQuery(`
+	SELECT
+		mz_sources.id,
+		mz_sources.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_postgres_sources.replication_slot
+	FROM mz_sources
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_internal.mz_postgres_sources
+		ON mz_sources.id = mz_postgres_sources.id`)
+
+func ScanSourcePostgres(conn *sqlx.DB, id string) (SourcePostgresDefinition, error) {
+	q := sourcePostgresQuery.QueryPredicate(map[string]string{"mz_sources.id": id})
+
+	var c SourcePostgresParams
+	if err := conn.Get(&c, q); err != nil {
+		return SourcePostgresDefinition{}, err
+	}
+
+	qn := QualifiedName(c.DatabaseName.String, c.SchemaName.String, c.SourceName.String)
+	createSql, err := ShowCreate(conn, BaseSource, qn)
+	if err != nil {
+		return SourcePostgresDefinition{}, err
+	}
+
+	s, err := ParseSourcePostgres(createSql)
+	if err != nil {
+		return s, fmt.Errorf("unable to parse definition of source %s: %w", qn, err)
+	}
+	s.ReplicationSlot = c.ReplicationSlot.String
+
+	return s, nil
+}
+
+// Subsource of a Postgres source, with the upstream table it ingests and its
+// status
+type SourcePostgresTableParams struct {
+	SubsourceId        sql.NullString `db:"id"`
+	SubsourceName      sql.NullString `db:"name"`
+	SchemaName         sql.NullString `db:"schema_name"`
+	DatabaseName       sql.NullString `db:"database_name"`
+	UpstreamSchemaName sql.NullString `db:"upstream_schema_name"`
+	UpstreamTableName  sql.NullString `db:"upstream_table_name"`
+	Status             sql.NullString `db:"status"`
+	Error              sql.NullString `db:"error"`
+}
+
+// Error Materialize reports for a subsource whose upstream table was altered
+// in an incompatible way, such as `source must be dropped and recreated due
+// to failure: incompatible schema change: source table t with oid 16385 has
+// been altered`
+const incompatibleSchemaChangeError = "incompatible schema change: "
+
+// SchemaChanged reports if the subsource stopped ingesting because its
+// upstream table was altered in an incompatible way.
+func (s SourcePostgresTableParams) SchemaChanged() bool {
+	if s.Status.String != "stalled" && s.Status.String != "ceased" {
+		return false
+	}
+	return strings.Contains(s.Error.String, incompatibleSchemaChangeError)
+}
+
+var sourcePostgresTableQuery = NewBaseQuery(`
+	SELECT
+		mz_sources.id,
+		mz_sources.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_postgres_source_tables.schema_name AS upstream_schema_name,
+		mz_postgres_source_tables.table_name AS upstream_table_name,
+		mz_source_statuses.status,
+		mz_source_statuses.error
+	FROM mz_internal.mz_object_dependencies
+	JOIN mz_sources
+		ON mz_object_dependencies.referenced_object_id = mz_sources.id
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_internal.mz_postgres_source_tables
+		ON mz_sources.id = mz_postgres_source_tables.id
+	LEFT JOIN mz_internal.mz_source_statuses
+		ON mz_sources.id = mz_source_statuses.id`)
+
+// ListSourcePostgresTables returns the subsources of a Postgres source
+// ingesting upstream tables. The progress subsource is not included.
+func ListSourcePostgresTables(conn *sqlx.DB, sourceId string) ([]SourcePostgresTableParams, error) {
+	p := map[string]string{
+		"mz_object_dependencies.object_id": sourceId,
+	}
+	q := sourcePostgresTableQuery.QueryPredicate(p)
+
+	var c []SourcePostgresTableParams
+	if err := conn.Select(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
@@ -1,11 +1,13 @@
 package materialize
 
 import (
+	"database/sql"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 var sourcePostgres = MaterializeObject{Name: "source", SchemaName: "schema", DatabaseName: "database"}
@@ -35,6 +37,27 @@ func TestSourcePostgresAllTablesCreate(t *testing.T) {
 	})
 }
 
+func TestSourcePostgresNoSubsourcesCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			IN CLUSTER "cluster"
+			FROM POSTGRES CONNECTION "database"."schema"."pg_connection"
+			\(PUBLICATION 'mz_source'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourcePostgresBuilder(db, sourcePostgres)
+		b.ClusterName("cluster")
+		b.PostgresConnection(IdentifierSchemaStruct{Name: "pg_connection", SchemaName: "schema", DatabaseName: "database"})
+		b.Publication("mz_source")
+		b.AllTables(false)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestSourcePostgresSchemasCreate(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
@@ -133,3 +156,47 @@ func TestSourceDropSubsource(t *testing.T) {
 		}
 	})
 }
+
+func TestParseSourcePostgres(t *testing.T) {
+	r := require.New(t)
+	s, err := ParseSourcePostgres(`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM POSTGRES CONNECTION "database"."schema"."pg_connection" (PUBLICATION = 'mz_source', DETAILS = '0a1b') FOR TABLES ("postgres"."public"."t" AS "database"."schema"."t") EXPOSE PROGRESS AS "database"."schema"."progress"`)
+	r.NoError(err)
+
+	r.Equal(IdentifierSchemaStruct{Name: "pg_connection", SchemaName: "schema", DatabaseName: "database"}, s.PostgresConnection)
+	r.Equal("mz_source", s.Publication)
+	r.Equal("progress", s.ExposeProgress.Name)
+}
+
+func TestListSourcePostgresTables(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockSourcePostgresTableSchemaChangeScan(mock, `WHERE mz_object_dependencies.object_id = 'u1'`)
+
+		s, err := ListSourcePostgresTables(db, "u1")
+		r.NoError(err)
+		r.Len(s, 2)
+		r.Equal("alias", s[0].SubsourceName.String)
+		r.Equal("name1", s[0].UpstreamTableName.String)
+		r.False(s[0].SchemaChanged())
+		r.True(s[1].SchemaChanged())
+	})
+}
+
+func TestSourcePostgresTableSchemaChanged(t *testing.T) {
+	r := require.New(t)
+	status := func(status, err string) SourcePostgresTableParams {
+		return SourcePostgresTableParams{
+			Status: sql.NullString{String: status, Valid: true},
+			Error:  sql.NullString{String: err, Valid: err != ""},
+		}
+	}
+
+	r.True(status("stalled", "source must be dropped and recreated due to failure: incompatible schema change: source table t with oid 16385 has been altered").SchemaChanged())
+	r.True(status("ceased", "source must be dropped and recreated due to failure: incompatible schema change: source table t with oid 16385 has been altered").SchemaChanged())
+	// Other definite errors of the subsource are not fixed by a new snapshot
+	r.False(status("stalled", "source must be dropped and recreated due to failure: table was dropped").SchemaChanged())
+	r.False(status("stalled", "source must be dropped and recreated due to failure: table was truncated").SchemaChanged())
+	r.False(status("stalled", `source must be dropped and recreated due to failure: publication "mz_source" does not exist`).SchemaChanged())
+	r.False(status("stalled", "connection refused").SchemaChanged())
+	r.False(status("running", "").SchemaChanged())
+}
@@ -0,0 +1,311 @@
+package materialize
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Table ingesting an upstream table or topic of a source, created with
+// `CREATE TABLE ... FROM SOURCE`
+type SourceTableBuilder struct {
+	ddl                Builder
+	tableName          string
+	schemaName         string
+	databaseName       string
+	source             IdentifierSchemaStruct
+	upstreamName       string
+	upstreamSchemaName string
+	textColumns        []string
+	excludeColumns     []string
+	format             SourceFormatSpecStruct
+	keyFormat          SourceFormatSpecStruct
+	valueFormat        SourceFormatSpecStruct
+	envelope           KafkaSourceEnvelopeStruct
+}
+
+func NewSourceTableBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceTableBuilder {
+	return &SourceTableBuilder{
+		ddl:          Builder{conn, Table},
+		tableName:    obj.Name,
+		schemaName:   obj.SchemaName,
+		databaseName: obj.DatabaseName,
+	}
+}
+
+func (b *SourceTableBuilder) QualifiedName() string {
+	return QualifiedName(b.databaseName, b.schemaName, b.tableName)
+}
+
+func (b *SourceTableBuilder) Source(s IdentifierSchemaStruct) *SourceTableBuilder {
+	b.source = s
+	return b
+}
+
+func (b *SourceTableBuilder) UpstreamName(n string) *SourceTableBuilder {
+	b.upstreamName = n
+	return b
+}
+
+func (b *SourceTableBuilder) UpstreamSchemaName(n string) *SourceTableBuilder {
+	b.upstreamSchemaName = n
+	return b
+}
+
+func (b *SourceTableBuilder) TextColumns(c []string) *SourceTableBuilder {
+	b.textColumns = c
+	return b
+}
+
+func (b *SourceTableBuilder) ExcludeColumns(c []string) *SourceTableBuilder {
+	b.excludeColumns = c
+	return b
+}
+
+func (b *SourceTableBuilder) Format(f SourceFormatSpecStruct) *SourceTableBuilder {
+	b.format = f
+	return b
+}
+
+func (b *SourceTableBuilder) KeyFormat(f SourceFormatSpecStruct) *SourceTableBuilder {
+	b.keyFormat = f
+	return b
+}
+
+func (b *SourceTableBuilder) ValueFormat(f SourceFormatSpecStruct) *SourceTableBuilder {
+	b.valueFormat = f
+	return b
+}
+
+func (b *SourceTableBuilder) Envelope(e KafkaSourceEnvelopeStruct) *SourceTableBuilder {
+	b.envelope = e
+	return b
+}
+
+func (b *SourceTableBuilder) Create() error {
+	q := strings.Builder{}
+	q.WriteString(fmt.Sprintf(`CREATE TABLE %s FROM SOURCE %s`, b.QualifiedName(), b.source.QualifiedName()))
+
+	// Upstream table of Postgres and MySQL sources, or topic of Kafka sources
+	if b.upstreamName != "" {
+		r := QualifiedName(b.upstreamName)
+		if b.upstreamSchemaName != "" {
+			r = QualifiedName(b.upstreamSchemaName, b.upstreamName)
+		}
+		q.WriteString(fmt.Sprintf(` (REFERENCE %s)`, r))
+	}
+
+	q.WriteString(sourceFormatClause("FORMAT", b.format))
+	q.WriteString(sourceFormatClause("KEY FORMAT", b.keyFormat))
+	q.WriteString(sourceFormatClause("VALUE FORMAT", b.valueFormat))
+	q.WriteString(sourceEnvelopeClause(b.envelope))
+
+	var o []string
+	if len(b.textColumns) > 0 {
+		o = append(o, fmt.Sprintf(`TEXT COLUMNS (%s)`, quoteIdentifiers(b.textColumns)))
+	}
+	if len(b.excludeColumns) > 0 {
+		o = append(o, fmt.Sprintf(`EXCLUDE COLUMNS (%s)`, quoteIdentifiers(b.excludeColumns)))
+	}
+	if len(o) > 0 {
+		q.WriteString(fmt.Sprintf(` WITH (%s)`, strings.Join(o, ", ")))
+	}
+
+	q.WriteString(`;`)
+	return b.ddl.exec(q.String())
+}
+
+func (b *SourceTableBuilder) Rename(newName string) error {
+	n := QualifiedName(newName)
+	return b.ddl.rename(b.QualifiedName(), n)
+}
+
+func (b *SourceTableBuilder) Drop() error {
+	qn := b.QualifiedName()
+	return b.ddl.drop(qn)
+}
+
+func quoteIdentifiers(identifiers []string) string {
+	var q []string
+	for _, i := range identifiers {
+		q = append(q, QuoteIdentifier(i))
+	}
+	return strings.Join(q, ", ")
+}
+
+type SourceTableParams struct {
+	TableId            sql.NullString `db:"id"`
+	TableName          sql.NullString `db:"name"`
+	SchemaName         sql.NullString `db:"schema_name"`
+	DatabaseName       sql.NullString `db:"database_name"`
+	SourceName         sql.NullString `db:"source_name"`
+	SourceSchemaName   sql.NullString `db:"source_schema_name"`
+	SourceDatabaseName sql.NullString `db:"source_database_name"`
+	Comment            sql.NullString `db:"comment"`
+	OwnerName          sql.NullString `db:"owner_name"`
+}
+
+var sourceTableQuery = NewBaseQuery(`
+	SELECT
+		mz_tables.id,
+		mz_tables.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_sources.name AS source_name,
+		source_schemas.name AS source_schema_name,
+		source_databases.name AS source_database_name,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name
+	FROM mz_tables
+	JOIN mz_schemas
+		ON mz_tables.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_sources
+		ON mz_tables.source_id = mz_sources.id
+	JOIN mz_schemas source_schemas
+		ON mz_sources.schema_id = source_schemas.id
+	JOIN mz_databases source_databases
+		ON source_schemas.database_id = source_databases.id
+	JOIN mz_roles
+		ON mz_tables.owner_id = mz_roles.id
+	LEFT JOIN (
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'table'
+		AND object_sub_id IS NULL
+	) comments
+		ON mz_tables.id = comments.id`)
+
+func SourceTableId(conn *sqlx.DB, obj MaterializeObject) (string, error) {
+	p := map[string]string{
+		"mz_tables.name":    obj.Name,
+		"mz_schemas.name":   obj.SchemaName,
+		"mz_databases.name": obj.DatabaseName,
+	}
+	q := sourceTableQuery.QueryPredicate(p)
+
+	var c SourceTableParams
+	if err := conn.Get(&c, q); err != nil {
+		return "", err
+	}
+
+	return c.TableId.String, nil
+}
+
+func ScanSourceTable(conn *sqlx.DB, id string) (SourceTableParams, error) {
+	p := map[string]string{
+		"mz_tables.id": id,
+	}
+	q := sourceTableQuery.QueryPredicate(p)
+
+	var c SourceTableParams
+	if err := conn.Get(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// Configuration of an existing source table, as reported by `SHOW CREATE`
+type SourceTableDefinition struct {
+	Source             IdentifierSchemaStruct
+	UpstreamName       string
+	UpstreamSchemaName string
+	TextColumns        []string
+	ExcludeColumns     []string
+	Format             SourceFormatSpecStruct
+	KeyFormat          SourceFormatSpecStruct
+	ValueFormat        SourceFormatSpecStruct
+	Envelope           KafkaSourceEnvelopeStruct
+}
+
+func ParseSourceTable(createSql string) (SourceTableDefinition, error) {
+	var t SourceTableDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return t, err
+	}
+
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("FROM", "SOURCE"):
+			if t.Source, err = p.parseQualifiedName(); err != nil {
+				return t, err
+			}
+			if !p.peekSymbol("(") {
+				continue
+			}
+			options, err := p.parseOptionList()
+			if err != nil {
+				return t, err
+			}
+			for _, o := range options {
+				if o.Name != "REFERENCE" {
+					continue
+				}
+				// Postgres references are qualified with the upstream database
+				r, err := o.parser().parseQualifiedName()
+				if err != nil {
+					return t, err
+				}
+				t.UpstreamName = r.Name
+				t.UpstreamSchemaName = r.SchemaName
+			}
+		case p.acceptKeyword("KEY", "FORMAT"):
+			if t.KeyFormat, err = p.parseFormatSpec(); err != nil {
+				return t, err
+			}
+		case p.acceptKeyword("VALUE", "FORMAT"):
+			if t.ValueFormat, err = p.parseFormatSpec(); err != nil {
+				return t, err
+			}
+		case p.acceptKeyword("FORMAT"):
+			if t.Format, err = p.parseFormatSpec(); err != nil {
+				return t, err
+			}
+		case p.acceptKeyword("ENVELOPE"):
+			if t.Envelope, err = parseKafkaEnvelope(p); err != nil {
+				return t, err
+			}
+		case p.acceptKeywordBeforeSymbol("WITH", "("):
+			options, err := p.parseOptionList()
+			if err != nil {
+				return t, err
+			}
+			for _, o := range options {
+				switch o.Name {
+				case "TEXT COLUMNS":
+					if t.TextColumns, err = o.parser().parseIdentifierList(); err != nil {
+						return t, err
+					}
+				case "EXCLUDE COLUMNS":
+					if t.ExcludeColumns, err = o.parser().parseIdentifierList(); err != nil {
+						return t, err
+					}
+				}
+			}
+		default:
+			p.skip()
+		}
+	}
+
+	return t, nil
+}
+
+func ScanSourceTableDefinition(conn *sqlx.DB, qualifiedName string) (SourceTableDefinition, error) {
+	createSql, err := ShowCreate(conn, Table, qualifiedName)
+	if err != nil {
+		return SourceTableDefinition{}, err
+	}
+
+	t, err := ParseSourceTable(createSql)
+	if err != nil {
+		return t, fmt.Errorf("unable to parse definition of table %s: %w", qualifiedName, err)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,130 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+// https://materialize.com/docs/sql/create-table/
+
+var sourceTable = MaterializeObject{Name: "table", SchemaName: "schema", DatabaseName: "database"}
+
+func TestSourceTablePostgresCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "database"."schema"."source"
+			\(REFERENCE "public"."table"\)
+			WITH \(TEXT COLUMNS \("a", "b"\), EXCLUDE COLUMNS \("c"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceTableBuilder(db, sourceTable)
+		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "schema", DatabaseName: "database"})
+		b.UpstreamName("table")
+		b.UpstreamSchemaName("public")
+		b.TextColumns([]string{"a", "b"})
+		b.ExcludeColumns([]string{"c"})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableKafkaCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "database"."schema"."source"
+			\(REFERENCE "topic"\)
+			FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_connection"
+			ENVELOPE UPSERT \(VALUE DECODING ERRORS = INLINE\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceTableBuilder(db, sourceTable)
+		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "schema", DatabaseName: "database"})
+		b.UpstreamName("topic")
+		b.Format(SourceFormatSpecStruct{
+			Avro: &AvroFormatSpec{
+				SchemaRegistryConnection: IdentifierSchemaStruct{Name: "csr_connection", SchemaName: "schema", DatabaseName: "database"},
+			},
+		})
+		b.Envelope(KafkaSourceEnvelopeStruct{
+			Upsert:        true,
+			UpsertOptions: UpsertOptionsStruct{ValueDecodingErrors: ValueDecodingErrorsStruct{Inline: true}},
+		})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableKafkaKeyValueFormatCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "database"."schema"."source"
+			KEY FORMAT TEXT VALUE FORMAT JSON ENVELOPE NONE;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceTableBuilder(db, sourceTable)
+		b.Source(IdentifierSchemaStruct{Name: "source", SchemaName: "schema", DatabaseName: "database"})
+		b.KeyFormat(SourceFormatSpecStruct{Text: true})
+		b.ValueFormat(SourceFormatSpecStruct{Json: true})
+		b.Envelope(KafkaSourceEnvelopeStruct{None: true})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableRename(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER TABLE "database"."schema"."table" RENAME TO "new_table";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSourceTableBuilder(db, sourceTable).Rename("new_table"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSourceTableDrop(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`DROP TABLE "database"."schema"."table";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSourceTableBuilder(db, sourceTable).Drop(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestParseSourceTablePostgres(t *testing.T) {
+	r := require.New(t)
+
+	s, err := ParseSourceTable(`CREATE TABLE "materialize"."public"."table" ("a" "pg_catalog"."int4", "b" "pg_catalog"."text") FROM SOURCE "materialize"."public"."pg_source" (REFERENCE = "postgres"."public"."table") WITH (TEXT COLUMNS = ("b"), EXCLUDE COLUMNS = ("c", "d"), DETAILS = '0a1b')`)
+	r.NoError(err)
+	r.Equal(IdentifierSchemaStruct{Name: "pg_source", SchemaName: "public", DatabaseName: "materialize"}, s.Source)
+	r.Equal("table", s.UpstreamName)
+	r.Equal("public", s.UpstreamSchemaName)
+	r.Equal([]string{"b"}, s.TextColumns)
+	r.Equal([]string{"c", "d"}, s.ExcludeColumns)
+}
+
+func TestParseSourceTableKafka(t *testing.T) {
+	r := require.New(t)
+
+	s, err := ParseSourceTable(`CREATE TABLE "materialize"."public"."table" FROM SOURCE "materialize"."public"."kafka_source" (REFERENCE = "topic") KEY FORMAT TEXT VALUE FORMAT JSON INCLUDE KEY ENVELOPE UPSERT`)
+	r.NoError(err)
+	r.Equal("topic", s.UpstreamName)
+	r.Equal("", s.UpstreamSchemaName)
+	r.True(s.KeyFormat.Text)
+	r.True(s.ValueFormat.Json)
+	r.True(s.Envelope.Upsert)
+}
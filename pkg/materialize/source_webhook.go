@@ -40,6 +40,8 @@ type SourceWebhookBuilder struct {
 	includeHeaders  IncludeHeadersStruct
 	checkOptions    []CheckOptionsStruct
 	checkExpression string
+	preset          string
+	presetSecret    IdentifierSchemaStruct
 }
 
 func NewSourceWebhookBuilder(conn *sqlx.DB, obj MaterializeObject) *SourceWebhookBuilder {
@@ -79,12 +81,30 @@ func (b *SourceWebhookBuilder) CheckExpression(e string) *SourceWebhookBuilder {
 	return b
 }
 
+// ValidationPreset validates the requests of a vendor with the secret, in
+// place of the check options and expression.
+func (b *SourceWebhookBuilder) ValidationPreset(vendor string, secret IdentifierSchemaStruct) *SourceWebhookBuilder {
+	b.preset = vendor
+	b.presetSecret = secret
+	return b
+}
+
 func (b *SourceWebhookBuilder) Size(s string) *SourceWebhookBuilder {
 	b.size = s
 	return b
 }
 
 func (b *SourceWebhookBuilder) Create() error {
+	if b.preset != "" {
+		p, err := GetWebhookPreset(b.preset, b.presetSecret)
+		if err != nil {
+			return err
+		}
+		b.includeHeader = append(b.includeHeader, p.IncludeHeader...)
+		b.checkOptions = p.CheckOptions
+		b.checkExpression = p.CheckExpression
+	}
+
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE SOURCE %s`, b.QualifiedName()))
 	q.WriteString(fmt.Sprintf(` IN CLUSTER %s`, QuoteIdentifier(b.clusterName)))
@@ -149,3 +169,151 @@ func (b *SourceWebhookBuilder) Create() error {
 
 	return b.ddl.exec(q.String())
 }
+
+// Configuration of an existing webhook source, as reported by the catalog
+type SourceWebhookDefinition struct {
+	BodyFormat     string
+	IncludeHeader  []HeaderStruct
+	IncludeHeaders IncludeHeadersStruct
+	// Check expressions are kept as Materialize formats them, compare them
+	// with EquivalentSql
+	CheckOptions    []CheckOptionsStruct
+	CheckExpression string
+	Url             string
+}
+
+func ParseSourceWebhook(createSql string) (SourceWebhookDefinition, error) {
+	var s SourceWebhookDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return s, err
+	}
+
+	for !p.done() {
+		switch {
+		case p.acceptKeyword("BODY", "FORMAT"):
+			f, err := p.parseIdentifier()
+			if err != nil {
+				return s, err
+			}
+			s.BodyFormat = strings.ToUpper(f)
+		case p.acceptKeyword("INCLUDE", "HEADER"):
+			var h HeaderStruct
+			if h.Header, err = p.parseString(); err != nil {
+				return s, err
+			}
+			if p.acceptKeyword("AS") {
+				if h.Alias, err = p.parseIdentifier(); err != nil {
+					return s, err
+				}
+			}
+			h.Bytes = p.acceptKeyword("BYTES")
+			s.IncludeHeader = append(s.IncludeHeader, h)
+		case p.acceptKeyword("INCLUDE", "HEADERS"):
+			if !p.acceptSymbol("(") {
+				s.IncludeHeaders.All = true
+				continue
+			}
+			for !p.acceptSymbol(")") {
+				not := p.acceptKeyword("NOT")
+				h, err := p.parseString()
+				if err != nil {
+					return s, err
+				}
+				if not {
+					s.IncludeHeaders.Not = append(s.IncludeHeaders.Not, h)
+				} else {
+					s.IncludeHeaders.Only = append(s.IncludeHeaders.Only, h)
+				}
+				p.acceptSymbol(",")
+			}
+		case p.acceptKeyword("CHECK"):
+			if err := p.parseCheck(&s); err != nil {
+				return s, err
+			}
+		default:
+			p.skip()
+		}
+	}
+
+	return s, nil
+}
+
+// parseCheck reads a `CHECK ([WITH (...)] <expression>)` clause.
+func (p *sqlParser) parseCheck(s *SourceWebhookDefinition) error {
+	if err := p.expectSymbol("("); err != nil {
+		return err
+	}
+
+	if p.acceptKeyword("WITH") {
+		if err := p.expectSymbol("("); err != nil {
+			return err
+		}
+		for !p.acceptSymbol(")") {
+			var o CheckOptionsStruct
+			var err error
+			switch {
+			case p.acceptKeyword("BODY"):
+				o.Field.Body = true
+			case p.acceptKeyword("HEADERS"):
+				o.Field.Headers = true
+			case p.acceptKeyword("SECRET"):
+				if o.Field.Secret, err = p.parseQualifiedName(); err != nil {
+					return err
+				}
+			default:
+				return p.unexpected("BODY, HEADERS or SECRET")
+			}
+			if p.acceptKeyword("AS") {
+				if o.Alias, err = p.parseIdentifier(); err != nil {
+					return err
+				}
+			}
+			o.Bytes = p.acceptKeyword("BYTES")
+			s.CheckOptions = append(s.CheckOptions, o)
+
+			if !p.acceptSymbol(",") && !p.peekSymbol(")") {
+				return p.unexpected("',' or ')'")
+			}
+		}
+	}
+
+	start := p.pos
+	for !p.done() && !p.peekSymbol(")") {
+		p.skip()
+	}
+	s.CheckExpression = p.text(start, p.pos)
+	return p.expectSymbol(")")
+}
+
+var sourceWebhookUrlQuery = NewBaseQuery(`
+	SELECT mz_webhook_sources.url
+	FROM mz_internal.mz_webhook_sources`)
+
+func ScanSourceWebhook(conn *sqlx.DB, id string) (SourceWebhookDefinition, error) {
+	q := sourceQuery.QueryPredicate(map[string]string{"mz_sources.id": id})
+
+	var c SourceParams
+	if err := conn.Get(&c, q); err != nil {
+		return SourceWebhookDefinition{}, err
+	}
+
+	qn := QualifiedName(c.DatabaseName.String, c.SchemaName.String, c.SourceName.String)
+	createSql, err := ShowCreate(conn, BaseSource, qn)
+	if err != nil {
+		return SourceWebhookDefinition{}, err
+	}
+
+	s, err := ParseSourceWebhook(createSql)
+	if err != nil {
+		return s, fmt.Errorf("unable to parse definition of source %s: %w", qn, err)
+	}
+
+	u := sourceWebhookUrlQuery.QueryPredicate(map[string]string{"mz_webhook_sources.id": id})
+	if err := conn.Get(&s.Url, u); err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
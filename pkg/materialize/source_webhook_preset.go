@@ -0,0 +1,85 @@
+package materialize
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Vendors with a validation preset for webhook sources. SHARED_SECRET is not
+// a vendor, it checks the authorization header of vendors that do not sign
+// their requests in a way a CHECK expression can verify.
+var WebhookPresetVendors = []string{
+	"SEGMENT",
+	"STRIPE",
+	"GITHUB",
+	"SHARED_SECRET",
+}
+
+// WebhookPreset is the validation of the requests of a vendor, expanded into
+// the options of a webhook source.
+type WebhookPreset struct {
+	CheckOptions    []CheckOptionsStruct
+	CheckExpression string
+	IncludeHeader   []HeaderStruct
+}
+
+// GetWebhookPreset returns the validation of the requests of a vendor, using
+// the signing secret or the credentials stored in secret. Signatures are
+// compared in constant time.
+func GetWebhookPreset(vendor string, secret IdentifierSchemaStruct) (WebhookPreset, error) {
+	headers := CheckOptionsStruct{Field: FieldStruct{Headers: true}}
+	secretBytes := CheckOptionsStruct{Field: FieldStruct{Secret: secret}, Alias: "secret", Bytes: true}
+	secretText := CheckOptionsStruct{Field: FieldStruct{Secret: secret}, Alias: "secret"}
+
+	switch strings.ToUpper(vendor) {
+	case "SEGMENT":
+		// Hex encoded HMAC-SHA1 of the body
+		return WebhookPreset{
+			CheckOptions: []CheckOptionsStruct{
+				{Field: FieldStruct{Body: true}, Bytes: true},
+				headers,
+				secretBytes,
+			},
+			CheckExpression: `constant_time_eq(decode(headers->'x-signature', 'hex'), hmac(body, secret, 'sha1'))`,
+		}, nil
+	case "STRIPE":
+		// Hex encoded HMAC-SHA256 of `<timestamp>.<body>`, sent as
+		// `t=<timestamp>,v1=<signature>`
+		return WebhookPreset{
+			CheckOptions: []CheckOptionsStruct{
+				{Field: FieldStruct{Body: true}},
+				headers,
+				secretText,
+			},
+			CheckExpression: `constant_time_eq(decode(split_part(split_part(headers->'stripe-signature', 'v1=', 2), ',', 1), 'hex'), hmac(split_part(split_part(headers->'stripe-signature', 't=', 2), ',', 1) || '.' || body, secret, 'sha256'))`,
+		}, nil
+	case "GITHUB":
+		// Hex encoded HMAC-SHA256 of the body, prefixed with `sha256=`
+		return WebhookPreset{
+			CheckOptions: []CheckOptionsStruct{
+				{Field: FieldStruct{Body: true}, Bytes: true},
+				headers,
+				secretBytes,
+			},
+			CheckExpression: `constant_time_eq(decode(substring(headers->'x-hub-signature-256', 8), 'hex'), hmac(body, secret, 'sha256'))`,
+			IncludeHeader: []HeaderStruct{
+				{Header: "x-github-event", Alias: "event_type"},
+				{Header: "x-github-delivery", Alias: "delivery_id"},
+			},
+		}, nil
+	case "SHARED_SECRET":
+		// The secret holds the full value of the authorization header, such
+		// as `Basic <credentials>` for basic authentication. The signatures
+		// of Twilio (HMAC of the URL and form parameters) and SendGrid
+		// (ECDSA) cannot be verified in a CHECK expression
+		return WebhookPreset{
+			CheckOptions: []CheckOptionsStruct{
+				headers,
+				secretText,
+			},
+			CheckExpression: `constant_time_eq(headers->'authorization', secret)`,
+		}, nil
+	}
+
+	return WebhookPreset{}, fmt.Errorf("unsupported webhook validation preset %s, expected one of %v", vendor, WebhookPresetVendors)
+}
@@ -0,0 +1,79 @@
+package materialize
+
+import (
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+var webhookPresetSecret = IdentifierSchemaStruct{DatabaseName: "database", SchemaName: "schema", Name: "webhook_secret"}
+
+func TestSourceWebhookPresetCreate(t *testing.T) {
+	for _, tc := range []struct {
+		vendor string
+		sql    string
+	}{
+		{
+			"SEGMENT",
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON CHECK ( WITH (BODY BYTES, HEADERS, SECRET "database"."schema"."webhook_secret" AS secret BYTES) constant_time_eq(decode(headers->'x-signature', 'hex'), hmac(body, secret, 'sha1')));`,
+		},
+		{
+			"stripe",
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON CHECK ( WITH (BODY, HEADERS, SECRET "database"."schema"."webhook_secret" AS secret) constant_time_eq(decode(split_part(split_part(headers->'stripe-signature', 'v1=', 2), ',', 1), 'hex'), hmac(split_part(split_part(headers->'stripe-signature', 't=', 2), ',', 1) || '.' || body, secret, 'sha256')));`,
+		},
+		{
+			"GITHUB",
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON INCLUDE HEADER 'x-github-event' AS event_type INCLUDE HEADER 'x-github-delivery' AS delivery_id CHECK ( WITH (BODY BYTES, HEADERS, SECRET "database"."schema"."webhook_secret" AS secret BYTES) constant_time_eq(decode(substring(headers->'x-hub-signature-256', 8), 'hex'), hmac(body, secret, 'sha256')));`,
+		},
+		{
+			"shared_secret",
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON CHECK ( WITH (HEADERS, SECRET "database"."schema"."webhook_secret" AS secret) constant_time_eq(headers->'authorization', secret));`,
+		},
+	} {
+		t.Run(tc.vendor, func(t *testing.T) {
+			testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(tc.sql)).WillReturnResult(sqlmock.NewResult(1, 1))
+
+				b := NewSourceWebhookBuilder(db, sourceWebhook)
+				b.ClusterName("cluster")
+				b.BodyFormat("JSON")
+				b.ValidationPreset(tc.vendor, webhookPresetSecret)
+
+				if err := b.Create(); err != nil {
+					t.Fatal(err)
+				}
+			})
+		})
+	}
+}
+
+func TestSourceWebhookPresetKeepsIncludeHeader(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(regexp.QuoteMeta(
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON INCLUDE HEADER 'x-request-id' AS request_id INCLUDE HEADER 'x-github-event' AS event_type INCLUDE HEADER 'x-github-delivery' AS delivery_id CHECK`,
+		)).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		b := NewSourceWebhookBuilder(db, sourceWebhook)
+		b.ClusterName("cluster")
+		b.BodyFormat("JSON")
+		b.IncludeHeader([]HeaderStruct{{Header: "x-request-id", Alias: "request_id"}})
+		b.ValidationPreset("GITHUB", webhookPresetSecret)
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestGetWebhookPresetUnknown(t *testing.T) {
+	_, err := GetWebhookPreset("MAILCHIMP", webhookPresetSecret)
+	require.ErrorContains(t, err, "unsupported webhook validation preset MAILCHIMP")
+
+	// Vendors whose signatures cannot be verified use SHARED_SECRET
+	_, err = GetWebhookPreset("TWILIO", webhookPresetSecret)
+	require.Error(t, err)
+}
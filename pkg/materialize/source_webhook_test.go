@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 var sourceWebhook = MaterializeObject{Name: "webhook_source", SchemaName: "schema", DatabaseName: "database"}
@@ -190,3 +191,30 @@ func TestSourceWebhookCreateRudderstack(t *testing.T) {
 		}
 	})
 }
+
+func TestParseSourceWebhook(t *testing.T) {
+	r := require.New(t)
+	s, err := ParseSourceWebhook(`CREATE SOURCE "database"."schema"."webhook" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT json INCLUDE HEADER 'timestamp' AS "ts" BYTES INCLUDE HEADERS ('x-event', NOT 'authorization') CHECK (WITH (BODY AS "body") length(body) > 0)`)
+	r.NoError(err)
+
+	r.Equal("JSON", s.BodyFormat)
+	r.Equal([]HeaderStruct{{Header: "timestamp", Alias: "ts", Bytes: true}}, s.IncludeHeader)
+	r.Equal(IncludeHeadersStruct{Only: []string{"x-event"}, Not: []string{"authorization"}}, s.IncludeHeaders)
+	r.Equal([]CheckOptionsStruct{{Field: FieldStruct{Body: true}, Alias: "body"}}, s.CheckOptions)
+	r.Equal("length(body) > 0", s.CheckExpression)
+}
+
+func TestParseSourceWebhookCheckSecret(t *testing.T) {
+	r := require.New(t)
+	s, err := ParseSourceWebhook(`CREATE SOURCE "database"."schema"."webhook" FROM WEBHOOK BODY FORMAT TEXT CHECK (WITH (HEADERS, BODY AS "request_body" BYTES, SECRET "materialize"."public"."secret" AS "validation_secret") decode(headers -> 'authorization', 'base64') = validation_secret)`)
+	r.NoError(err)
+
+	r.Equal([]CheckOptionsStruct{
+		{Field: FieldStruct{Headers: true}},
+		{Field: FieldStruct{Body: true}, Alias: "request_body", Bytes: true},
+		{Field: FieldStruct{Secret: IdentifierSchemaStruct{Name: "secret", SchemaName: "public", DatabaseName: "materialize"}}, Alias: "validation_secret"},
+	}, s.CheckOptions)
+	r.Equal("decode(headers -> 'authorization', 'base64') = validation_secret", s.CheckExpression)
+	r.True(EquivalentSql(s.CheckExpression, "DECODE(headers->'authorization','base64') = \"validation_secret\""))
+	r.False(EquivalentSql(s.CheckExpression, "decode(headers->'authorization', 'hex') = validation_secret"))
+}
@@ -0,0 +1,88 @@
+package materialize
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// DDL
+type SqlStatementBuilder struct {
+	ddl Builder
+}
+
+func NewSqlStatementBuilder(conn *sqlx.DB) *SqlStatementBuilder {
+	return &SqlStatementBuilder{
+		ddl: Builder{conn: conn},
+	}
+}
+
+// Exec runs a user supplied statement as is.
+func (b *SqlStatementBuilder) Exec(statement string) error {
+	s := strings.TrimSpace(statement)
+	if s == "" {
+		return errors.New("statement cannot be empty")
+	}
+	return b.ddl.exec(s)
+}
+
+// DML
+
+// QuerySqlStatement runs a user supplied query and returns every row as a map
+// of column name to its value formatted as a string. NULL values are returned
+// as empty strings. Rows are sorted, so the result does not depend on the
+// order the query returns them in.
+func QuerySqlStatement(conn *sqlx.DB, query string) ([]map[string]string, error) {
+	rows, err := conn.Queryx(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []map[string]string
+	for rows.Next() {
+		r := map[string]interface{}{}
+		if err := rows.MapScan(r); err != nil {
+			return nil, err
+		}
+
+		m := map[string]string{}
+		for k, v := range r {
+			switch t := v.(type) {
+			case nil:
+				m[k] = ""
+			case []byte:
+				m[k] = string(t)
+			default:
+				m[k] = fmt.Sprint(t)
+			}
+		}
+		result = append(result, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return rowKey(result[i]) < rowKey(result[j])
+	})
+	return result, nil
+}
+
+// rowKey formats a row with its columns in name order.
+func rowKey(row map[string]string) string {
+	var columns []string
+	for k := range row {
+		columns = append(columns, k)
+	}
+	sort.Strings(columns)
+
+	var k strings.Builder
+	for _, c := range columns {
+		k.WriteString(fmt.Sprintf("%q=%q,", c, row[c]))
+	}
+	return k.String()
+}
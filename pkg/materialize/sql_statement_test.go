@@ -0,0 +1,57 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqlStatementExec(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE CONNECTION mysql_connection TO MYSQL \(HOST 'localhost'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSqlStatementBuilder(db).Exec(" CREATE CONNECTION mysql_connection TO MYSQL (HOST 'localhost')\n"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSqlStatementExecEmpty(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		require.Error(t, NewSqlStatementBuilder(db).Exec("  "))
+	})
+}
+
+func TestQuerySqlStatement(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		ir := mock.NewRows([]string{"id", "name", "size"}).
+			AddRow("u1", []byte("mysql_connection"), 3).
+			AddRow("u2", "other", nil)
+		mock.ExpectQuery(`SELECT id, name, size FROM mz_connections;`).WillReturnRows(ir)
+
+		rows, err := QuerySqlStatement(db, `SELECT id, name, size FROM mz_connections;`)
+		r.NoError(err)
+		r.Equal([]map[string]string{
+			{"id": "u1", "name": "mysql_connection", "size": "3"},
+			{"id": "u2", "name": "other", "size": ""},
+		}, rows)
+	})
+}
+
+func TestQuerySqlStatementOrder(t *testing.T) {
+	r := require.New(t)
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		ir := mock.NewRows([]string{"id"}).AddRow("u2").AddRow("u1")
+		mock.ExpectQuery(`SELECT id FROM mz_connections;`).WillReturnRows(ir)
+
+		rows, err := QuerySqlStatement(db, `SELECT id FROM mz_connections;`)
+		r.NoError(err)
+		r.Equal([]map[string]string{{"id": "u1"}, {"id": "u2"}}, rows)
+	})
+}
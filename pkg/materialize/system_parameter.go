@@ -0,0 +1,61 @@
+package materialize
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// DDL
+type SystemParameterBuilder struct {
+	ddl           Builder
+	parameterName string
+}
+
+func NewSystemParameterBuilder(conn *sqlx.DB, name string) *SystemParameterBuilder {
+	return &SystemParameterBuilder{
+		ddl:           Builder{conn, SystemParameter},
+		parameterName: name,
+	}
+}
+
+func (b *SystemParameterBuilder) Set(value string) error {
+	q := fmt.Sprintf(`ALTER SYSTEM SET %s = %s;`, QuoteIdentifier(b.parameterName), QuoteString(value))
+	return b.ddl.exec(q)
+}
+
+// Reset sets the parameter back to its default value.
+func (b *SystemParameterBuilder) Reset() error {
+	q := fmt.Sprintf(`ALTER SYSTEM RESET %s;`, QuoteIdentifier(b.parameterName))
+	return b.ddl.exec(q)
+}
+
+// DML
+type SystemParameterParams struct {
+	Name        string `db:"name"`
+	Setting     string `db:"setting"`
+	Description string `db:"description"`
+}
+
+// ScanSystemParameter returns the current value of a system parameter.
+func ScanSystemParameter(conn *sqlx.DB, name string) (string, error) {
+	q := fmt.Sprintf(`SHOW %s;`, QuoteIdentifier(name))
+
+	var value string
+	if err := conn.QueryRow(q).Scan(&value); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+// ListSystemParameters returns the parameters visible to the user with their
+// current value.
+func ListSystemParameters(conn *sqlx.DB) ([]SystemParameterParams, error) {
+	var c []SystemParameterParams
+	if err := conn.Select(&c, `SHOW ALL;`); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,44 @@
+package materialize
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestSystemParameterSet(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SYSTEM SET "max_clusters" = '100';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSystemParameterBuilder(db, "max_clusters").Set("100"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestSystemParameterReset(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SYSTEM RESET "max_clusters";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := NewSystemParameterBuilder(db, "max_clusters").Reset(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestScanSystemParameter(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		testhelpers.MockSystemParameterScan(mock, "max_clusters", "100")
+
+		value, err := ScanSystemParameter(db, "max_clusters")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if value != "100" {
+			t.Fatalf("unexpected value %s", value)
+		}
+	})
+}
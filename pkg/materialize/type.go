@@ -132,6 +132,11 @@ func (b *Type) Create() error {
 	return b.ddl.exec(q.String())
 }
 
+func (b *Type) Rename(newName string) error {
+	n := QualifiedName(newName)
+	return b.ddl.rename(b.QualifiedName(), n)
+}
+
 func (b *Type) Drop() error {
 	qn := b.QualifiedName()
 	return b.ddl.drop(qn)
@@ -216,3 +221,186 @@ func ListTypes(conn *sqlx.DB, schemaName, databaseName string) ([]TypeParams, er
 
 	return c, nil
 }
+
+// TypeReference returns the name of a type as used in a type definition.
+// Built-in types are not qualified, custom types are qualified with their
+// schema and database.
+func TypeReference(name, schemaName, databaseName sql.NullString) string {
+	if !databaseName.Valid {
+		return name.String
+	}
+	return QualifiedName(databaseName.String, schemaName.String, name.String)
+}
+
+type ListTypeParams struct {
+	TypeId              sql.NullString `db:"id"`
+	ElementName         sql.NullString `db:"element_name"`
+	ElementSchemaName   sql.NullString `db:"element_schema_name"`
+	ElementDatabaseName sql.NullString `db:"element_database_name"`
+}
+
+var listTypeQuery = NewBaseQuery(`
+	SELECT
+		mz_list_types.id,
+		element_types.name AS element_name,
+		element_schemas.name AS element_schema_name,
+		element_databases.name AS element_database_name
+	FROM mz_list_types
+	JOIN mz_types element_types
+		ON mz_list_types.element_id = element_types.id
+	JOIN mz_schemas element_schemas
+		ON element_types.schema_id = element_schemas.id
+	LEFT JOIN mz_databases element_databases
+		ON element_schemas.database_id = element_databases.id`)
+
+func ScanListType(conn *sqlx.DB, id string) (ListTypeParams, error) {
+	q := listTypeQuery.QueryPredicate(map[string]string{"mz_list_types.id": id})
+
+	var c ListTypeParams
+	if err := conn.Get(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+type MapTypeParams struct {
+	TypeId            sql.NullString `db:"id"`
+	KeyName           sql.NullString `db:"key_name"`
+	KeySchemaName     sql.NullString `db:"key_schema_name"`
+	KeyDatabaseName   sql.NullString `db:"key_database_name"`
+	ValueName         sql.NullString `db:"value_name"`
+	ValueSchemaName   sql.NullString `db:"value_schema_name"`
+	ValueDatabaseName sql.NullString `db:"value_database_name"`
+}
+
+var mapTypeQuery = NewBaseQuery(`
+	SELECT
+		mz_map_types.id,
+		key_types.name AS key_name,
+		key_schemas.name AS key_schema_name,
+		key_databases.name AS key_database_name,
+		value_types.name AS value_name,
+		value_schemas.name AS value_schema_name,
+		value_databases.name AS value_database_name
+	FROM mz_map_types
+	JOIN mz_types key_types
+		ON mz_map_types.key_id = key_types.id
+	JOIN mz_schemas key_schemas
+		ON key_types.schema_id = key_schemas.id
+	LEFT JOIN mz_databases key_databases
+		ON key_schemas.database_id = key_databases.id
+	JOIN mz_types value_types
+		ON mz_map_types.value_id = value_types.id
+	JOIN mz_schemas value_schemas
+		ON value_types.schema_id = value_schemas.id
+	LEFT JOIN mz_databases value_databases
+		ON value_schemas.database_id = value_databases.id`)
+
+func ScanMapType(conn *sqlx.DB, id string) (MapTypeParams, error) {
+	q := mapTypeQuery.QueryPredicate(map[string]string{"mz_map_types.id": id})
+
+	var c MapTypeParams
+	if err := conn.Get(&c, q); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// ScanTypeDefinition reads the properties of a type from the catalog, using
+// `SHOW CREATE` for the fields of row types.
+func ScanTypeDefinition(conn *sqlx.DB, t TypeParams) (TypeDefinition, error) {
+	var d TypeDefinition
+
+	switch t.Category.String {
+	case "list":
+		l, err := ScanListType(conn, t.TypeId.String)
+		if err != nil {
+			return d, err
+		}
+		d.ListProperties = []ListProperties{
+			{ElementType: TypeReference(l.ElementName, l.ElementSchemaName, l.ElementDatabaseName)},
+		}
+	case "map":
+		m, err := ScanMapType(conn, t.TypeId.String)
+		if err != nil {
+			return d, err
+		}
+		d.MapProperties = []MapProperties{
+			{
+				KeyType:   TypeReference(m.KeyName, m.KeySchemaName, m.KeyDatabaseName),
+				ValueType: TypeReference(m.ValueName, m.ValueSchemaName, m.ValueDatabaseName),
+			},
+		}
+	default:
+		qn := QualifiedName(t.DatabaseName.String, t.SchemaName.String, t.TypeName.String)
+		createSql, err := ShowCreate(conn, BaseType, qn)
+		if err != nil {
+			return d, err
+		}
+		if d, err = ParseType(createSql); err != nil {
+			return d, fmt.Errorf("unable to parse definition of type %s: %w", qn, err)
+		}
+	}
+
+	return d, nil
+}
+
+type TypeDefinition struct {
+	RowProperties  []RowProperties
+	ListProperties []ListProperties
+	MapProperties  []MapProperties
+}
+
+func ParseType(createSql string) (TypeDefinition, error) {
+	var t TypeDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return t, err
+	}
+
+	for !p.done() && !p.peekKeyword("AS") {
+		p.skip()
+	}
+	if !p.acceptKeyword("AS") {
+		return t, p.unexpected("AS")
+	}
+
+	list := p.acceptKeyword("LIST")
+	isMap := !list && p.acceptKeyword("MAP")
+	if err := p.expectSymbol("("); err != nil {
+		return t, err
+	}
+
+	var m MapProperties
+	for !p.done() && !p.acceptSymbol(")") {
+		switch {
+		case list && p.acceptKeyword("ELEMENT", "TYPE"):
+			p.acceptSymbol("=")
+			t.ListProperties = append(t.ListProperties, ListProperties{ElementType: p.skipType()})
+		case isMap && p.acceptKeyword("KEY", "TYPE"):
+			p.acceptSymbol("=")
+			m.KeyType = p.skipType()
+		case isMap && p.acceptKeyword("VALUE", "TYPE"):
+			p.acceptSymbol("=")
+			m.ValueType = p.skipType()
+		case !list && !isMap:
+			n, err := p.parseIdentifier()
+			if err != nil {
+				return t, err
+			}
+			t.RowProperties = append(t.RowProperties, RowProperties{FieldName: n, FieldType: p.skipType()})
+		default:
+			p.skipExpression()
+		}
+		p.acceptSymbol(",")
+	}
+
+	if isMap {
+		t.MapProperties = append(t.MapProperties, m)
+	}
+
+	return t, nil
+}
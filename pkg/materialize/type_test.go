@@ -1,11 +1,13 @@
 package materialize
 
 import (
+	"database/sql"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 // https://materialize.com/docs/sql/create-type/
@@ -76,6 +78,35 @@ func TestTypeCustomRowCreate(t *testing.T) {
 	})
 }
 
+func TestTypeCustomListNestedCreate(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE TYPE "database"."schema"."type" AS LIST \(ELEMENT TYPE = "database"."schema"."nested"\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "type", SchemaName: "schema", DatabaseName: "database"}
+		b := NewTypeBuilder(db, o)
+		b.ListProperties([]ListProperties{{ElementType: QualifiedName("database", "schema", "nested")}})
+
+		if err := b.Create(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestTypeRename(t *testing.T) {
+	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`ALTER TYPE "database"."schema"."type" RENAME TO "new_type";`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		o := MaterializeObject{Name: "type", SchemaName: "schema", DatabaseName: "database"}
+		if err := NewTypeBuilder(db, o).Rename("new_type"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestTypeDrop(t *testing.T) {
 	testhelpers.WithMockDb(t, func(db *sqlx.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(
@@ -88,3 +119,52 @@ func TestTypeDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestTypeRoundTrip(t *testing.T) {
+	r := require.New(t)
+	o := MaterializeObject{Name: "type", SchemaName: "schema", DatabaseName: "database"}
+
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewTypeBuilder(db, o).ListProperties([]ListProperties{{ElementType: "int4"}}).Create()
+	})
+	ty, err := ParseType(s)
+	r.NoError(err)
+	r.Equal(TypeDefinition{ListProperties: []ListProperties{{ElementType: "int4"}}}, ty)
+
+	s = createStatement(t, func(db *sqlx.DB) error {
+		return NewTypeBuilder(db, o).MapProperties([]MapProperties{{KeyType: "text", ValueType: "int"}}).Create()
+	})
+	ty, err = ParseType(s)
+	r.NoError(err)
+	r.Equal(TypeDefinition{MapProperties: []MapProperties{{KeyType: "text", ValueType: "int"}}}, ty)
+
+	rows := []RowProperties{{FieldName: "a", FieldType: "int4"}, {FieldName: "b", FieldType: "numeric(10, 2)"}}
+	s = createStatement(t, func(db *sqlx.DB) error {
+		return NewTypeBuilder(db, o).RowProperties(rows).Create()
+	})
+	ty, err = ParseType(s)
+	r.NoError(err)
+	r.Equal(TypeDefinition{RowProperties: rows}, ty)
+}
+
+func TestParseTypeNormalized(t *testing.T) {
+	r := require.New(t)
+	ty, err := ParseType(`CREATE TYPE "materialize"."public"."type" AS LIST (ELEMENT TYPE = "pg_catalog"."int4")`)
+	r.NoError(err)
+	r.Equal([]ListProperties{{ElementType: "int4"}}, ty.ListProperties)
+}
+
+func TestTypeReference(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("int4", TypeReference(
+		sql.NullString{String: "int4", Valid: true},
+		sql.NullString{String: "pg_catalog", Valid: true},
+		sql.NullString{},
+	))
+	r.Equal(`"database"."schema"."nested"`, TypeReference(
+		sql.NullString{String: "nested", Valid: true},
+		sql.NullString{String: "schema", Valid: true},
+		sql.NullString{String: "database", Valid: true},
+	))
+}
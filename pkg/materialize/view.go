@@ -132,3 +132,26 @@ func ListViews(conn *sqlx.DB, schemaName, databaseName string) ([]ViewParams, er
 
 	return c, nil
 }
+
+type ViewDefinition struct {
+	Statement string
+}
+
+func ParseView(createSql string) (ViewDefinition, error) {
+	var v ViewDefinition
+
+	p, err := newSqlParser(createSql)
+	if err != nil {
+		return v, err
+	}
+
+	for !p.done() && !p.peekKeyword("AS") {
+		p.skip()
+	}
+	if !p.acceptKeyword("AS") {
+		return v, p.unexpected("AS")
+	}
+	v.Statement = p.remainder()
+
+	return v, nil
+}
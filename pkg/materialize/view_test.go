@@ -6,6 +6,7 @@ import (
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
 )
 
 // https://github.com/MaterializeInc/materialize/blob/main/test/testdrive/create-views.td
@@ -52,3 +53,15 @@ func TestViewDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestViewRoundTrip(t *testing.T) {
+	r := require.New(t)
+	o := MaterializeObject{Name: "view", SchemaName: "schema", DatabaseName: "database"}
+	s := createStatement(t, func(db *sqlx.DB) error {
+		return NewViewBuilder(db, o).SelectStmt("SELECT 1 AS a FROM t1 WHERE b = 'as'").Create()
+	})
+
+	v, err := ParseView(s)
+	r.NoError(err)
+	r.Equal("SELECT 1 AS a FROM t1 WHERE b = 'as'", v.Statement)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConnAws_basic(t *testing.T) {
+	connectionName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAllConnAwsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnAwsResource(connectionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnAwsExists("materialize_connection_aws.test"),
+					resource.TestMatchResourceAttr("materialize_connection_aws.test", "id", terraformObjectIdRegex),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "name", connectionName),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "database_name", "materialize"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "schema_name", "public"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "qualified_sql_name", fmt.Sprintf(`"materialize"."public"."%s"`, connectionName)),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "aws_region", "us-east-1"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "assume_role_arn", "arn:aws:iam::123456789012:role/Materialize"),
+					resource.TestCheckResourceAttrSet("materialize_connection_aws.test", "external_id"),
+					resource.TestCheckResourceAttrSet("materialize_connection_aws.test", "example_trust_policy"),
+					resource.TestCheckResourceAttr("materialize_connection_aws.test", "comment", "object comment"),
+				),
+			},
+			{
+				ResourceName:      "materialize_connection_aws.test",
+				ImportState:       true,
+				ImportStateVerify: false,
+			},
+		},
+	})
+}
+
+func testAccConnAwsResource(connectionName string) string {
+	return fmt.Sprintf(`
+resource "materialize_connection_aws" "test" {
+	name            = "%[1]s"
+	schema_name     = "public"
+	aws_region      = "us-east-1"
+	assume_role_arn = "arn:aws:iam::123456789012:role/Materialize"
+	comment         = "object comment"
+}
+`, connectionName)
+}
+
+func testAccCheckConnAwsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		meta := testAccProvider.Meta()
+		db, _, err := utils.GetDBClientFromMeta(meta, nil)
+		if err != nil {
+			return fmt.Errorf("error getting DB client: %s", err)
+		}
+		r, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("connection aws not found: %s", name)
+		}
+		_, err = materialize.ScanConnectionAws(db, utils.ExtractId(r.Primary.ID))
+		return err
+	}
+}
+
+func testAccCheckAllConnAwsDestroyed(s *terraform.State) error {
+	meta := testAccProvider.Meta()
+	db, _, err := utils.GetDBClientFromMeta(meta, nil)
+	if err != nil {
+		return fmt.Errorf("error getting DB client: %s", err)
+	}
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "materialize_connection_aws" {
+			continue
+		}
+
+		_, err := materialize.ScanConnectionAws(db, utils.ExtractId(r.Primary.ID))
+		if err == nil {
+			return fmt.Errorf("connection %v still exists", utils.ExtractId(r.Primary.ID))
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+	}
+
+	return nil
+}
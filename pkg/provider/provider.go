@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/datasources"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	_ "github.com/jackc/pgx/stdlib"
 )
 
@@ -55,14 +58,47 @@ func Provider(version string) *schema.Provider {
 				Description: "The default region if not specified in the resource",
 				DefaultFunc: schema.EnvDefaultFunc("MZ_DEFAULT_REGION", "aws/us-east-1"),
 			},
+			"max_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "The maximum number of open connections to the database of each region.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"connection_idle_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				Description:  "The number of seconds after which an idle connection is closed. Set to `0` to keep idle connections open.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"connection_max_lifetime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1800,
+				Description:  "The number of seconds after which a connection is closed and reopened. Set to `0` to reuse connections forever.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"connect_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				Description:  "The number of seconds to wait for a connection to the database. Set to `0` to wait indefinitely.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"materialize_app_password":                         resources.AppPassword(),
 			"materialize_user":                                 resources.User(),
+			"materialize_scim_config":                          resources.SCIMConfig(),
+			"materialize_scim_group":                           resources.SCIMGroup(),
+			"materialize_sso_config":                           resources.SSOConfig(),
+			"materialize_sso_domain":                           resources.SSODomain(),
 			"materialize_cluster":                              resources.Cluster(),
 			"materialize_cluster_grant":                        resources.GrantCluster(),
 			"materialize_cluster_grant_default_privilege":      resources.GrantClusterDefaultPrivilege(),
 			"materialize_cluster_replica":                      resources.ClusterReplica(),
+			"materialize_connection_aws":                       resources.ConnectionAws(),
 			"materialize_connection_aws_privatelink":           resources.ConnectionAwsPrivatelink(),
 			"materialize_connection_confluent_schema_registry": resources.ConnectionConfluentSchemaRegistry(),
 			"materialize_connection_kafka":                     resources.ConnectionKafka(),
@@ -77,7 +113,9 @@ func Provider(version string) *schema.Provider {
 			"materialize_index":                                resources.Index(),
 			"materialize_materialized_view":                    resources.MaterializedView(),
 			"materialize_materialized_view_grant":              resources.GrantMaterializedView(),
+			"materialize_region":                               resources.Region(),
 			"materialize_role":                                 resources.Role(),
+			"materialize_user_database_role":                   resources.UserDatabaseRole(),
 			"materialize_role_grant":                           resources.GrantRole(),
 			"materialize_schema":                               resources.Schema(),
 			"materialize_schema_grant":                         resources.GrantSchema(),
@@ -90,7 +128,11 @@ func Provider(version string) *schema.Provider {
 			"materialize_source_load_generator":                resources.SourceLoadgen(),
 			"materialize_source_postgres":                      resources.SourcePostgres(),
 			"materialize_source_webhook":                       resources.SourceWebhook(),
+			"materialize_source_table":                         resources.SourceTable(),
+			"materialize_comment":                              resources.Comment(),
 			"materialize_source_grant":                         resources.GrantSource(),
+			"materialize_sql_statement":                        resources.SqlStatement(),
+			"materialize_system_parameter":                     resources.SystemParameter(),
 			"materialize_table":                                resources.Table(),
 			"materialize_table_grant":                          resources.GrantTable(),
 			"materialize_table_grant_default_privilege":        resources.GrantTableDefaultPrivilege(),
@@ -101,24 +143,28 @@ func Provider(version string) *schema.Provider {
 			"materialize_view_grant":                           resources.GrantView(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"materialize_cluster":           datasources.Cluster(),
-			"materialize_cluster_replica":   datasources.ClusterReplica(),
-			"materialize_connection":        datasources.Connection(),
-			"materialize_current_database":  datasources.CurrentDatabase(),
-			"materialize_current_cluster":   datasources.CurrentCluster(),
-			"materialize_database":          datasources.Database(),
-			"materialize_egress_ips":        datasources.EgressIps(),
-			"materialize_index":             datasources.Index(),
-			"materialize_materialized_view": datasources.MaterializedView(),
-			"materialize_region":            datasources.Region(),
-			"materialize_role":              datasources.Role(),
-			"materialize_schema":            datasources.Schema(),
-			"materialize_secret":            datasources.Secret(),
-			"materialize_sink":              datasources.Sink(),
-			"materialize_source":            datasources.Source(),
-			"materialize_table":             datasources.Table(),
-			"materialize_type":              datasources.Type(),
-			"materialize_view":              datasources.View(),
+			"materialize_cluster":               datasources.Cluster(),
+			"materialize_cluster_replica":       datasources.ClusterReplica(),
+			"materialize_cluster_replica_sizes": datasources.ClusterReplicaSizes(),
+			"materialize_cluster_utilization":   datasources.ClusterUtilization(),
+			"materialize_connection":            datasources.Connection(),
+			"materialize_current_database":      datasources.CurrentDatabase(),
+			"materialize_current_cluster":       datasources.CurrentCluster(),
+			"materialize_database":              datasources.Database(),
+			"materialize_egress_ips":            datasources.EgressIps(),
+			"materialize_index":                 datasources.Index(),
+			"materialize_materialized_view":     datasources.MaterializedView(),
+			"materialize_objects":               datasources.Objects(),
+			"materialize_region":                datasources.Region(),
+			"materialize_role":                  datasources.Role(),
+			"materialize_schema":                datasources.Schema(),
+			"materialize_secret":                datasources.Secret(),
+			"materialize_sink":                  datasources.Sink(),
+			"materialize_source":                datasources.Source(),
+			"materialize_system_parameters":     datasources.SystemParameters(),
+			"materialize_table":                 datasources.Table(),
+			"materialize_type":                  datasources.Type(),
+			"materialize_view":                  datasources.View(),
 		},
 		ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 			return providerConfigure(ctx, d, version)
@@ -148,68 +194,60 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, version stri
 
 	// Initialize the Cloud API client using the Frontegg client and endpoint
 	cloudAPIClient := clients.NewCloudAPIClient(fronteggClient, cloudEndpoint)
-	regionsEnabled := make(map[clients.Region]bool)
 
-	// Get the list of cloud providers
-	providers, err := cloudAPIClient.ListCloudProviders(ctx)
-	if err != nil {
-		return nil, diag.Errorf("Unable to list cloud providers: %s", err)
+	// Database clients are opened on the first use of each region
+	providerMeta := &utils.ProviderMeta{
+		DB:             make(map[clients.Region]*clients.DBClient),
+		Frontegg:       fronteggClient,
+		CloudAPI:       cloudAPIClient,
+		DefaultRegion:  clients.Region(defaultRegion),
+		RegionsEnabled: make(map[clients.Region]bool),
+		DBConfig: utils.DBConfig{
+			Database:        database,
+			SslMode:         sslmode,
+			ApplicationName: application_name,
+			Version:         version,
+			Pool: clients.DBPoolConfig{
+				MaxOpenConns:    d.Get("max_connections").(int),
+				ConnMaxIdleTime: time.Duration(d.Get("connection_idle_timeout").(int)) * time.Second,
+				ConnMaxLifetime: time.Duration(d.Get("connection_max_lifetime").(int)) * time.Second,
+				ConnectTimeout:  time.Duration(d.Get("connect_timeout").(int)) * time.Second,
+			},
+		},
 	}
 
-	// Store the DB clients for all regions.
-	dbClients := make(map[clients.Region]*clients.DBClient)
-
-	for _, provider := range providers {
-		regionDetails, err := cloudAPIClient.GetRegionDetails(ctx, provider)
-
-		log.Printf("[DEBUG] Region details for provider %s: %v\n", provider.ID, regionDetails)
-
-		if err != nil {
-			log.Printf("[ERROR] Error getting region details for provider %s: %v\n", provider.ID, err)
-			continue
-		}
-
-		// Check if regionDetails or RegionInfo is nil before proceeding
-		if regionDetails == nil || regionDetails.RegionInfo == nil {
-			continue
-		}
-
-		regionsEnabled[clients.Region(provider.ID)] = regionDetails.RegionInfo != nil && regionDetails.RegionInfo.Resolvable
-
-		// Get the database connection details for the region
-		host, port, err := clients.SplitHostPort(regionDetails.RegionInfo.SqlAddress)
-		if err != nil {
-			log.Printf("[ERROR] Error splitting host and port for region %s: %v\n", provider.ID, err)
-			continue
-		}
-
-		user := fronteggClient.Email
+	return providerMeta, pingDefaultRegion(ctx, providerMeta)
+}
 
-		// Instantiate a new DB client for the region
-		dbClient, diags := clients.NewDBClient(host, user, password, port, database, application_name, version, sslmode)
-		if diags.HasError() {
-			log.Printf("[ERROR] Error initializing DB client for region %s: %v\n", provider.ID, diags)
-			continue
+// pingDefaultRegion checks the connection to the default region. A region
+// that is not enabled yet only raises a warning, as it can still be enabled
+// with the materialize_region resource.
+func pingDefaultRegion(ctx context.Context, providerMeta *utils.ProviderMeta) diag.Diagnostics {
+	db, region, err := utils.GetDBClientFromMeta(providerMeta, nil)
+	if err != nil {
+		var notEnabled *utils.RegionNotEnabledError
+		if errors.As(err, &notEnabled) {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Default region %s is not enabled", region),
+				Detail:   "Resources in the default region cannot be managed until the region is enabled.",
+			}}
 		}
-
-		dbClients[clients.Region(provider.ID)] = dbClient
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to connect to default region %s", region),
+			Detail:   err.Error(),
+		}}
 	}
 
-	// Check if at least one region has been initialized successfully
-	if len(dbClients) == 0 {
-		return nil, diag.Errorf("No database regions were initialized. Please check your configuration.")
-	}
-
-	log.Printf("[DEBUG] Initialized DB clients for regions: %v\n", dbClients)
-
-	// Construct and return the provider meta with all clients initialized.
-	providerMeta := &utils.ProviderMeta{
-		DB:             dbClients,
-		Frontegg:       fronteggClient,
-		CloudAPI:       cloudAPIClient,
-		DefaultRegion:  clients.Region(defaultRegion),
-		RegionsEnabled: regionsEnabled,
+	if err := db.PingContext(ctx); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to connect to default region %s", region),
+			Detail:   fmt.Sprintf("Ping to the database failed: %s", err),
+		}}
 	}
 
-	return providerMeta, nil
+	log.Printf("[DEBUG] Connected to default region %s\n", region)
+	return nil
 }
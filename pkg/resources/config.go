@@ -5,10 +5,46 @@ var loadGeneratorTypes = []string{
 	"MARKETING",
 	"COUNTER",
 	"TPCH",
+	"KEY VALUE",
+	"CLOCK",
+}
+
+// Load generators with several tables, which can be selected with FOR TABLES
+var loadGeneratorTableTypes = []string{
+	"AUCTION",
+	"MARKETING",
+	"TPCH",
+}
+
+// Load generator of each options block
+var loadGeneratorOptions = map[string]string{
+	"counter_options":   "COUNTER",
+	"auction_options":   "AUCTION",
+	"marketing_options": "MARKETING",
+	"tpch_options":      "TPCH",
+	"key_value_options": "KEY VALUE",
+	"clock_options":     "CLOCK",
 }
 
 // https://materialize.com/docs/sql/create-cluster-replica/#sizes
+// The sizes are discovered from the catalog of the region when it can be
+// reached, this list is only used to validate sizes when it cannot.
 var replicaSizes = []string{
+	"25cc",
+	"50cc",
+	"100cc",
+	"200cc",
+	"300cc",
+	"400cc",
+	"600cc",
+	"800cc",
+	"1200cc",
+	"1600cc",
+	"3200cc",
+	"6400cc",
+	"128C",
+	"256C",
+	"512C",
 	"3xsmall",
 	"2xsmall",
 	"xsmall",
@@ -45,19 +81,51 @@ var strategy = []string{
 	"LATEST",
 }
 
+// Names of built-in types as reported for table columns, by alias
 var aliases = map[string]string{
-	"int8":    "bigint",
-	"bool":    "boolean",
-	"float":   "double precision",
-	"float8":  "double precision",
-	"double":  "double precision",
-	"int":     "integer",
-	"int4":    "integer",
-	"json":    "jsonb",
-	"decimal": "numeric",
-	"real":    "float4",
-	"int2":    "smallint",
-	"uint":    "uint4",
+	"int8":                        "bigint",
+	"bool":                        "boolean",
+	"float":                       "double precision",
+	"float8":                      "double precision",
+	"double":                      "double precision",
+	"int":                         "integer",
+	"int4":                        "integer",
+	"json":                        "jsonb",
+	"decimal":                     "numeric",
+	"real":                        "float4",
+	"int2":                        "smallint",
+	"uint":                        "uint4",
+	"varchar":                     "character varying",
+	"bpchar":                      "character",
+	"char":                        "character",
+	"timestamptz":                 "timestamp with time zone",
+	"timestamp without time zone": "timestamp",
+	"time without time zone":      "time",
+}
+
+var commentObjectTypes = []string{
+	"CLUSTER",
+	"CLUSTER REPLICA",
+	"CONNECTION",
+	"DATABASE",
+	"INDEX",
+	"MATERIALIZED VIEW",
+	"ROLE",
+	"SCHEMA",
+	"SECRET",
+	"SINK",
+	"SOURCE",
+	"TABLE",
+	"TYPE",
+	"VIEW",
+}
+
+// Relations whose columns can be commented on
+var commentColumnObjectTypes = []string{
+	"MATERIALIZED VIEW",
+	"SOURCE",
+	"TABLE",
+	"VIEW",
 }
 
 var securityProtocols = []string{
@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+)
+
+const (
+	ssoConfigurationsPath  = "/frontegg/team/resources/sso/v1/configurations"
+	scimConfigurationsPath = "/frontegg/directory/resources/v1/configurations/scim2"
+	groupsPath             = "/frontegg/identity/resources/groups/v1"
+)
+
+// errFronteggNotFound is returned by fronteggRequest when the API responds
+// with a 404 status code.
+var errFronteggNotFound = errors.New("resource not found")
+
+// fronteggRequest sends a JSON request to the Frontegg API through the
+// authenticated HTTP client and decodes the response into out, if set.
+func fronteggRequest(ctx context.Context, client *clients.FronteggClient, method, path string, body, out interface{}) error {
+	var requestBody []byte
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request: %s", err)
+		}
+		requestBody = b
+	}
+
+	resp, err := doRequest(ctx, client, method, getApiEndpoint(client, path), requestBody)
+	if err != nil {
+		return fmt.Errorf("error sending request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errFronteggNotFound
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error: %s - %s", resp.Status, string(responseBody))
+	}
+
+	if out != nil && resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+			return fmt.Errorf("error decoding response: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// getRoleNames maps the roles of a user or group to their names, keeping the
+// spelling of the configured roles.
+func getRoleNames(roles []FronteggRole, configured []string) []string {
+	var roleNames []string
+	for _, role := range roles {
+		roleNames = append(roleNames, roleAlias(role.Name))
+	}
+	return orderRoleNames(roleNames, configured)
+}
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importFromCreateSql reads the object and then sets the attributes the
+// catalog does not expose from its `SHOW CREATE` statement, so that the first
+// plan after an import does not propose to replace the object.
+func importFromCreateSql(read schema.ReadContextFunc, objectType materialize.EntityType, set func(*schema.ResourceData, string) error) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if diags := read(ctx, d, meta); diags.HasError() {
+			return nil, fmt.Errorf("reading %s: %s", objectType, diags[0].Summary)
+		}
+		if d.Id() == "" {
+			return nil, fmt.Errorf("%s not found", objectType)
+		}
+
+		metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+		if err != nil {
+			return nil, err
+		}
+		createSql, err := materialize.ShowCreate(metaDb, objectType, d.Get("qualified_sql_name").(string))
+		if err != nil {
+			return nil, err
+		}
+		if err := set(d, createSql); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func setAttributes(d *schema.ResourceData, attributes map[string]interface{}) error {
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flattenValueSecret(v materialize.ValueSecretStruct) []interface{} {
+	if v.Text != "" {
+		return []interface{}{map[string]interface{}{"text": v.Text}}
+	}
+	if v.Secret.Name != "" {
+		return []interface{}{map[string]interface{}{"secret": flattenIdentifierSchema(v.Secret)}}
+	}
+	return []interface{}{}
+}
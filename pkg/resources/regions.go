@@ -0,0 +1,392 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func RegionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:   "The regions to deploy the resource to. The resource is created, updated and dropped in every region, and regions can be added or removed in place. Regions the resource could not be created in are reported as warnings and created again on the next apply. Conflicts with `region`.",
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		ConflictsWith: []string{"region"},
+	}
+}
+
+func RegionIdsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The ID of the resource in each of its `regions`.",
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+	}
+}
+
+// withRegions adds the regions attribute to a resource. When it is set, the
+// operations of the resource run once per region against the database client
+// of the region, and the ID of the resource in each region is kept in
+// region_ids. A region the resource is missing from, or could not be created
+// in, is removed from the state, so the next plan adds it back.
+func withRegions(r *schema.Resource) *schema.Resource {
+	s := make(map[string]*schema.Schema, len(r.Schema)+2)
+	for k, v := range r.Schema {
+		s[k] = v
+	}
+	s["regions"] = RegionsSchema()
+	s["region_ids"] = RegionIdsSchema()
+
+	m := &regionsResource{
+		create: r.CreateContext,
+		read:   r.ReadContext,
+		update: r.UpdateContext,
+		delete: r.DeleteContext,
+		schema: r.Schema,
+	}
+
+	r.Schema = s
+	r.CreateContext = m.Create
+	r.ReadContext = m.Read
+	r.DeleteContext = m.Delete
+
+	// Regions are added and removed in place
+	r.UpdateContext = m.Update
+
+	// Moving between a single region and several regions replaces the resource
+	customizeDiff := []schema.CustomizeDiffFunc{
+		customdiff.ForceNewIfChange("regions", func(ctx context.Context, old, new, meta interface{}) bool {
+			return (old.(*schema.Set).Len() == 0) != (new.(*schema.Set).Len() == 0)
+		}),
+	}
+	if r.CustomizeDiff != nil {
+		customizeDiff = append(customizeDiff, r.CustomizeDiff)
+	}
+	r.CustomizeDiff = customdiff.All(customizeDiff...)
+
+	return r
+}
+
+type regionsResource struct {
+	create schema.CreateContextFunc
+	read   schema.ReadContextFunc
+	update schema.UpdateContextFunc
+	delete schema.DeleteContextFunc
+	schema map[string]*schema.Schema
+}
+
+func (m *regionsResource) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	regions := getRegions(d.Get("regions"))
+	if len(regions) == 0 {
+		return m.create(ctx, d, meta)
+	}
+
+	ids, diags := m.createIn(ctx, d, meta, regions, map[string]string{})
+	if len(ids) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	// The regions that failed are created again on the next apply
+	diags = asWarnings(diags)
+
+	// The ID of the first region identifies the resource
+	d.SetId(ids[getRegions(ids)[0]])
+
+	return append(diags, m.setRegionIds(d, ids)...)
+}
+
+func (m *regionsResource) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ids := d.Get("region_ids").(map[string]interface{})
+	if len(ids) == 0 {
+		return m.read(ctx, d, meta)
+	}
+
+	id := d.Id()
+	found := map[string]string{}
+	snapshot := m.values(d)
+	var drifted map[string]interface{}
+	var diags diag.Diagnostics
+
+	for _, region := range getRegions(ids) {
+		if err := m.restore(d, snapshot); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(ids[region].(string))
+		if regionDiags := m.read(ctx, d, regionMeta(meta, region)); regionDiags.HasError() {
+			diags = append(diags, inRegion(region, regionDiags)...)
+			found[region] = ids[region].(string)
+			continue
+		}
+
+		// The resource is missing from the region
+		if d.Id() == "" {
+			continue
+		}
+		found[region] = d.Id()
+
+		// Report the values of the first region that differ from the state
+		values := m.values(d)
+		for k, v := range values {
+			if _, ok := drifted[k]; !ok && !valuesEqual(v, snapshot[k]) {
+				if drifted == nil {
+					drifted = map[string]interface{}{}
+				}
+				drifted[k] = v
+			}
+		}
+	}
+
+	if len(found) == 0 {
+		d.SetId("")
+		return diags
+	}
+	d.SetId(id)
+
+	for k, v := range snapshot {
+		if dv, ok := drifted[k]; ok {
+			v = dv
+		}
+		if err := d.Set(k, v); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return append(diags, m.setRegionIds(d, found)...)
+}
+
+func (m *regionsResource) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ids := map[string]string{}
+	for region, id := range d.Get("region_ids").(map[string]interface{}) {
+		ids[region] = id.(string)
+	}
+
+	if len(ids) == 0 {
+		if m.update == nil {
+			return m.read(ctx, d, meta)
+		}
+		return m.update(ctx, d, meta)
+	}
+
+	id := d.Id()
+	snapshot := m.values(d)
+	o, n := d.GetChange("regions")
+	removed := getRegions(o.(*schema.Set).Difference(n.(*schema.Set)))
+	added := getRegions(n.(*schema.Set).Difference(o.(*schema.Set)))
+	var diags diag.Diagnostics
+
+	// Drop the removed regions with the values they were created with
+	if err := m.restore(d, m.priorValues(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, region := range removed {
+		d.SetId(ids[region])
+		if regionDiags := m.delete(ctx, d, regionMeta(meta, region)); regionDiags.HasError() {
+			diags = append(diags, inRegion(region, regionDiags)...)
+			continue
+		}
+		delete(ids, region)
+	}
+
+	if m.update != nil {
+		for _, region := range getRegions(ids) {
+			if contains(removed, region) {
+				continue
+			}
+			if err := m.restore(d, snapshot); err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(ids[region])
+			if regionDiags := m.update(ctx, d, regionMeta(meta, region)); regionDiags.HasError() {
+				diags = append(diags, inRegion(region, regionDiags)...)
+			}
+		}
+	}
+
+	// Regions that are already tracked are not created again
+	var missing []string
+	for _, region := range added {
+		if _, ok := ids[region]; !ok {
+			missing = append(missing, region)
+		}
+	}
+
+	if err := m.restore(d, snapshot); err != nil {
+		return diag.FromErr(err)
+	}
+	ids, createDiags := m.createIn(ctx, d, meta, missing, ids)
+	diags = append(diags, createDiags...)
+
+	d.SetId(id)
+	return append(diags, m.setRegionIds(d, ids)...)
+}
+
+func (m *regionsResource) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ids := d.Get("region_ids").(map[string]interface{})
+	if len(ids) == 0 {
+		return m.delete(ctx, d, meta)
+	}
+
+	id := d.Id()
+	failed := map[string]string{}
+	var diags diag.Diagnostics
+
+	for _, region := range getRegions(ids) {
+		d.SetId(ids[region].(string))
+		if regionDiags := m.delete(ctx, d, regionMeta(meta, region)); regionDiags.HasError() {
+			diags = append(diags, inRegion(region, regionDiags)...)
+			failed[region] = ids[region].(string)
+		}
+	}
+
+	if len(failed) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	// Keep the regions the resource could not be dropped from
+	d.SetId(id)
+	return append(diags, m.setRegionIds(d, failed)...)
+}
+
+// createIn creates the resource in each of the regions and adds the ID of
+// the regions it was created in to ids.
+func (m *regionsResource) createIn(ctx context.Context, d *schema.ResourceData, meta interface{}, regions []string, ids map[string]string) (map[string]string, diag.Diagnostics) {
+	snapshot := m.values(d)
+	var diags diag.Diagnostics
+
+	for _, region := range regions {
+		// Undo the values read back from the previous region
+		if err := m.restore(d, snapshot); err != nil {
+			return ids, diag.FromErr(err)
+		}
+		d.SetId("")
+		regionDiags := m.create(ctx, d, regionMeta(meta, region))
+		if d.Id() != "" {
+			ids[region] = d.Id()
+		}
+		if regionDiags.HasError() {
+			diags = append(diags, inRegion(region, regionDiags)...)
+		}
+	}
+
+	return ids, diags
+}
+
+// setRegionIds sets the regions the resource exists in and its ID in each.
+func (m *regionsResource) setRegionIds(d *schema.ResourceData, ids map[string]string) diag.Diagnostics {
+	regions := []string{}
+	for region := range ids {
+		regions = append(regions, region)
+	}
+
+	if err := setAttributes(d, map[string]interface{}{
+		"regions":    regions,
+		"region_ids": ids,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// values returns the values of the attributes of the underlying resource.
+func (m *regionsResource) values(d *schema.ResourceData) map[string]interface{} {
+	values := map[string]interface{}{}
+	for k := range m.schema {
+		if k != "region" {
+			values[k] = d.Get(k)
+		}
+	}
+	return values
+}
+
+// priorValues returns the values of the attributes of the underlying resource
+// before the update.
+func (m *regionsResource) priorValues(d *schema.ResourceData) map[string]interface{} {
+	values := map[string]interface{}{}
+	for k := range m.schema {
+		if k != "region" {
+			values[k], _ = d.GetChange(k)
+		}
+	}
+	return values
+}
+
+// restore sets the attributes of the underlying resource back to values.
+func (m *regionsResource) restore(d *schema.ResourceData, values map[string]interface{}) error {
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func regionMeta(meta interface{}, region string) interface{} {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return meta
+	}
+	return &utils.RegionMeta{ProviderMeta: providerMeta, Region: clients.Region(region)}
+}
+
+// getRegions returns the sorted regions of a regions set or region_ids map.
+func getRegions(v interface{}) []string {
+	var regions []string
+	switch v := v.(type) {
+	case *schema.Set:
+		regions = convertToStringSlice(v.List())
+	case map[string]interface{}:
+		for region := range v {
+			regions = append(regions, region)
+		}
+	case map[string]string:
+		for region := range v {
+			regions = append(regions, region)
+		}
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// inRegion prefixes the summary of diagnostics with the region they occurred in.
+func inRegion(region string, diags diag.Diagnostics) diag.Diagnostics {
+	for i := range diags {
+		diags[i].Summary = fmt.Sprintf("region %s: %s", region, diags[i].Summary)
+	}
+	return diags
+}
+
+// asWarnings downgrades the errors of diagnostics to warnings.
+func asWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	for i := range diags {
+		diags[i].Severity = diag.Warning
+	}
+	return diags
+}
+
+func valuesEqual(a, b interface{}) bool {
+	if as, ok := a.(*schema.Set); ok {
+		if bs, ok := b.(*schema.Set); ok {
+			return as.Equal(bs)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+var inRoleRegions = map[string]interface{}{
+	"name":    "role",
+	"inherit": true,
+	"regions": []interface{}{"aws/us-east-1", "aws/eu-west-1"},
+}
+
+// withMockRegions connects aws/eu-west-1 to the same mock database as the
+// default region.
+func withMockRegions(t *testing.T, f func(*utils.ProviderMeta, sqlmock.Sqlmock)) {
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		db.DB[clients.AwsEuWest1] = db.DB[clients.AwsUsEast1]
+		db.RegionsEnabled[clients.AwsEuWest1] = true
+		f(db, mock)
+	})
+}
+
+func TestResourceRoleCreateRegions(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Role().Schema, inRoleRegions)
+	r.NotNil(d)
+
+	withMockRegions(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		for i := 0; i < 2; i++ {
+			mock.ExpectExec(`CREATE ROLE "role" INHERIT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+			testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'role'`)
+			testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		}
+
+		diags := Role().CreateContext(context.TODO(), d, db)
+		r.False(diags.HasError(), diags)
+
+		r.Equal("aws/eu-west-1:u1", d.Id())
+		r.Equal(map[string]interface{}{
+			"aws/eu-west-1": "aws/eu-west-1:u1",
+			"aws/us-east-1": "aws/us-east-1:u1",
+		}, d.Get("region_ids"))
+		r.ElementsMatch([]interface{}{"aws/eu-west-1", "aws/us-east-1"}, d.Get("regions").(*schema.Set).List())
+	})
+}
+
+func TestResourceRoleCreateRegionsPartialFailure(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":    "role",
+		"inherit": true,
+		"regions": []interface{}{"aws/us-east-1", "aws/eu-west-1"},
+	}
+	d := schema.TestResourceDataRaw(t, Role().Schema, in)
+	r.NotNil(d)
+
+	// aws/eu-west-1 has no database client
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`CREATE ROLE "role" INHERIT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'role'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		diags := Role().CreateContext(context.TODO(), d, db)
+		r.False(diags.HasError(), diags)
+		r.Len(diags, 1)
+		r.Equal(diag.Warning, diags[0].Severity)
+		r.Contains(diags[0].Summary, "region aws/eu-west-1: ")
+
+		r.Equal("aws/us-east-1:u1", d.Id())
+		r.Equal([]interface{}{"aws/us-east-1"}, d.Get("regions").(*schema.Set).List())
+	})
+}
+
+func TestResourceRoleReadRegionsMissing(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Role().Schema, inRoleRegions)
+	r.NotNil(d)
+	d.SetId("aws/eu-west-1:u1")
+	r.NoError(d.Set("region_ids", map[string]interface{}{
+		"aws/eu-west-1": "aws/eu-west-1:u1",
+		"aws/us-east-1": "aws/us-east-1:u2",
+	}))
+
+	withMockRegions(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		// The role was dropped from aws/us-east-1
+		mock.ExpectQuery(`SELECT .* WHERE mz_roles.id = 'u2';`).WillReturnRows(
+			mock.NewRows([]string{"id", "role_name", "inherit"}),
+		)
+
+		diags := Role().ReadContext(context.TODO(), d, db)
+		r.False(diags.HasError(), diags)
+
+		r.Equal("aws/eu-west-1:u1", d.Id())
+		r.Equal(map[string]interface{}{"aws/eu-west-1": "aws/eu-west-1:u1"}, d.Get("region_ids"))
+		r.Equal([]interface{}{"aws/eu-west-1"}, d.Get("regions").(*schema.Set).List())
+	})
+}
+
+func TestResourceRoleDeleteRegions(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Role().Schema, inRoleRegions)
+	r.NotNil(d)
+	d.SetId("aws/eu-west-1:u1")
+	r.NoError(d.Set("region_ids", map[string]interface{}{
+		"aws/eu-west-1": "aws/eu-west-1:u1",
+		"aws/us-east-1": "aws/us-east-1:u1",
+	}))
+
+	withMockRegions(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP ROLE "role";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`DROP ROLE "role";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		diags := Role().DeleteContext(context.TODO(), d, db)
+		r.False(diags.HasError(), diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestResourceRoleUpdateAddRegion(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Role().Schema, inRoleRegions)
+	r.NotNil(d)
+	d.SetId("aws/eu-west-1:u1")
+	r.NoError(d.Set("region_ids", map[string]interface{}{
+		"aws/eu-west-1": "aws/eu-west-1:u1",
+	}))
+
+	withMockRegions(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Update in aws/eu-west-1
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		// Create in aws/us-east-1
+		mock.ExpectExec(`CREATE ROLE "role" INHERIT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'role'`)
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+
+		diags := Role().UpdateContext(context.TODO(), d, db)
+		r.False(diags.HasError(), diags)
+
+		r.Equal("aws/eu-west-1:u1", d.Id())
+		r.Equal(map[string]interface{}{
+			"aws/eu-west-1": "aws/eu-west-1:u1",
+			"aws/us-east-1": "aws/us-east-1:u1",
+		}, d.Get("region_ids"))
+	})
+}
+
+func TestResourceSecretUpdateRemoveRegion(t *testing.T) {
+	r := require.New(t)
+	prior := schema.TestResourceDataRaw(t, Secret().Schema, map[string]interface{}{
+		"name":          "old_secret",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"value":         "value",
+		"regions":       []interface{}{"aws/us-east-1", "aws/eu-west-1"},
+	})
+	prior.SetId("aws/eu-west-1:u1")
+	r.NoError(prior.Set("region_ids", map[string]interface{}{
+		"aws/eu-west-1": "aws/eu-west-1:u1",
+		"aws/us-east-1": "aws/us-east-1:u1",
+	}))
+	state := prior.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":          "secret",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"value":         "value",
+		"regions":       []interface{}{"aws/eu-west-1"},
+	})
+	diff, err := Secret().Diff(context.TODO(), state, config, nil)
+	r.NoError(err)
+	d, err := schema.InternalMap(Secret().Schema).Data(state, diff)
+	r.NoError(err)
+
+	withMockRegions(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// The secret is dropped from aws/us-east-1 under its previous name
+		mock.ExpectExec(`DROP SECRET "database"."schema"."old_secret";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Rename in aws/eu-west-1
+		mock.ExpectExec(`ALTER SECRET "database"."schema"."old_secret" RENAME TO "secret";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockSecretScan(mock, `WHERE mz_secrets.id = 'u1'`)
+
+		diags := Secret().UpdateContext(context.TODO(), d, db)
+		r.False(diags.HasError(), diags)
+
+		r.Equal("aws/eu-west-1:u1", d.Id())
+		r.Equal(map[string]interface{}{"aws/eu-west-1": "aws/eu-west-1:u1"}, d.Get("region_ids"))
+	})
+}
@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+)
+
+// discoveredSizes keeps the replica sizes listed from the catalog of each
+// database, so the catalog is queried once per region.
+var discoveredSizes = struct {
+	sync.Mutex
+	sizes map[*sqlx.DB][]string
+}{sizes: map[*sqlx.DB][]string{}}
+
+// validateReplicaSize checks that the size of a cluster or replica is one of
+// the sizes of its region. When the region cannot be reached, the static list
+// of sizes is used instead.
+func validateReplicaSize(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("size") || !d.NewValueKnown("size") {
+		return nil
+	}
+
+	size := d.Get("size").(string)
+	if size == "" {
+		return nil
+	}
+
+	sizes := getReplicaSizes(meta, clients.Region(d.Get("region").(string)))
+	for _, s := range sizes {
+		if strings.EqualFold(s, size) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("expected size to be one of %v, got %s", sizes, size)
+}
+
+// getReplicaSizes returns the replica sizes of a region, falling back to
+// replicaSizes when they cannot be listed.
+func getReplicaSizes(meta interface{}, region clients.Region) []string {
+	conn, region, err := utils.GetDBClientForRegion(meta, region)
+	if err != nil {
+		log.Printf("[DEBUG] cannot list the replica sizes of region %s, using the default sizes: %s", region, err)
+		return replicaSizes
+	}
+
+	discoveredSizes.Lock()
+	defer discoveredSizes.Unlock()
+
+	if sizes, ok := discoveredSizes.sizes[conn]; ok {
+		return sizes
+	}
+
+	params, err := materialize.ListClusterReplicaSizes(conn)
+	if err != nil || len(params) == 0 {
+		log.Printf("[DEBUG] cannot list the replica sizes of region %s, using the default sizes: %v", region, err)
+		return replicaSizes
+	}
+
+	sizes := make([]string, 0, len(params))
+	for _, p := range params {
+		sizes = append(sizes, p.Size.String)
+	}
+	discoveredSizes.sizes[conn] = sizes
+
+	return sizes
+}
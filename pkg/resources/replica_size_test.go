@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateReplicaSizeDiscovered(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// The sizes are listed once
+		testhelpers.MockClusterReplicaSizeScan(mock)
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "cluster", "size": "25cc"})
+		_, err := Cluster().Diff(context.TODO(), nil, config, db)
+		r.NoError(err)
+
+		// Sizes missing from the region are rejected even if they are known
+		config = terraform.NewResourceConfigRaw(map[string]interface{}{"name": "cluster", "size": "small"})
+		_, err = Cluster().Diff(context.TODO(), nil, config, db)
+		r.ErrorContains(err, "expected size to be one of [25cc 100cc], got small")
+
+		r.NoError(mock.ExpectationsWereMet())
+	})
+}
+
+func TestValidateReplicaSizeOffline(t *testing.T) {
+	r := require.New(t)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "replica", "cluster_name": "cluster", "size": "100cc"})
+	_, err := ClusterReplica().Diff(context.TODO(), nil, config, nil)
+	r.NoError(err)
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"name": "replica", "cluster_name": "cluster", "size": "huge"})
+	_, err = ClusterReplica().Diff(context.TODO(), nil, config, nil)
+	r.ErrorContains(err, "expected size to be one of")
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"strings"
 	"time"
@@ -13,25 +15,63 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
 )
 
 func AppPassword() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: appPasswordCreate,
 		ReadContext:   appPasswordRead,
+		UpdateContext: appPasswordUpdate,
 		DeleteContext: appPasswordDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: appPasswordImport,
 		},
 
+		CustomizeDiff: customdiff.All(validateAppPasswordType, rotateAppPassword),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "personal",
+				ValidateFunc: validation.StringInSlice([]string{"personal", "service"}, false),
+				Description:  "The type of the app password. Allowed values are 'personal', for a password that authenticates as the current user, and 'service', for a password that authenticates as the service user set in `user`.",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the service user the app password authenticates as. The user is created on first login. Required for the 'service' type.",
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The organization roles of the service user. Allowed values are 'Member', 'Admin' and the names of custom organization roles. Only valid for the 'service' type.",
+			},
+			"rotate_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validDuration,
+				Description:  "The age after which the app password is replaced, as a duration such as `720h`. The password is replaced on the first apply after it is older than this age.",
+			},
+			"age_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The age of the app password in seconds, as of the last refresh.",
+			},
 			"owner": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,6 +98,14 @@ type appPasswordCreateRequest struct {
 	Description string `json:"description"`
 }
 
+// servicePasswordCreateRequest is used to serialize the request body for
+// creating a tenant level app password.
+type servicePasswordCreateRequest struct {
+	Description string            `json:"description"`
+	Metadata    map[string]string `json:"metadata"`
+	RoleIDs     []string          `json:"roleIds"`
+}
+
 type appPasswordResponse struct {
 	ClientID    string    `json:"clientId"`
 	Description string    `json:"description"`
@@ -66,16 +114,87 @@ type appPasswordResponse struct {
 	Secret      string    `json:"secret"`
 }
 
+// servicePasswordResponse represents a tenant level app password in the
+// Frontegg API.
+type servicePasswordResponse struct {
+	ClientID    string            `json:"clientId"`
+	Description string            `json:"description"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	Secret      string            `json:"secret"`
+	Metadata    map[string]string `json:"metadata"`
+	RoleIDs     []string          `json:"roleIds"`
+}
+
 const (
-	apiTokenPath = "/identity/resources/users/api-tokens/v1"
+	apiTokenPath        = "/identity/resources/users/api-tokens/v1"
+	serviceApiTokenPath = "/identity/resources/tenants/api-tokens/v1"
 )
 
+// validateAppPasswordType checks that the service user and roles are only set
+// for service app passwords.
+func validateAppPasswordType(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	user := d.Get("user").(string)
+	roles := d.Get("roles").([]interface{})
+
+	if d.Get("type").(string) == "service" {
+		if user == "" && d.NewValueKnown("user") {
+			return fmt.Errorf("user is required for service app passwords")
+		}
+		return nil
+	}
+
+	if user != "" || len(roles) > 0 {
+		return fmt.Errorf("user and roles are only valid for service app passwords")
+	}
+	return nil
+}
+
+// rotateAppPassword replaces the app password when it is older than
+// rotate_after.
+func rotateAppPassword(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rotateAfter := d.Get("rotate_after").(string)
+	createdAt := d.Get("created_at").(string)
+	if d.Id() == "" || rotateAfter == "" || createdAt == "" {
+		return nil
+	}
+
+	due, err := appPasswordRotationDue(createdAt, rotateAfter, time.Now())
+	if err != nil || !due {
+		return err
+	}
+
+	for _, k := range []string{"password", "secret", "created_at", "age_seconds", "owner"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return d.ForceNew("password")
+}
+
+// appPasswordRotationDue reports whether an app password created at createdAt
+// is older than rotateAfter at now.
+func appPasswordRotationDue(createdAt, rotateAfter string, now time.Time) (bool, error) {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return false, fmt.Errorf("error parsing created_at: %s", err)
+	}
+	age, err := time.ParseDuration(rotateAfter)
+	if err != nil {
+		return false, fmt.Errorf("error parsing rotate_after: %s", err)
+	}
+	return !now.Before(created.Add(age)), nil
+}
+
 func appPasswordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta, err := utils.GetProviderMeta(meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.Get("type").(string) == "service" {
+		return servicePasswordCreate(ctx, d, providerMeta)
+	}
+
 	// Create the app password using the helper function.
 	response, err := createAppPassword(ctx, d, providerMeta.Frontegg)
 	if err != nil {
@@ -94,6 +213,9 @@ func appPasswordCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("created_at", response.CreatedAt.Format(time.RFC3339)); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("age_seconds", int(time.Since(response.CreatedAt).Seconds())); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("secret", response.Secret); err != nil {
 		return diag.FromErr(err)
 	}
@@ -107,6 +229,47 @@ func appPasswordCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// servicePasswordCreate creates a tenant level app password for a service user.
+func servicePasswordCreate(ctx context.Context, d *schema.ResourceData, providerMeta *utils.ProviderMeta) diag.Diagnostics {
+	client := providerMeta.Frontegg
+
+	createRequest := servicePasswordCreateRequest{
+		Description: d.Get("name").(string),
+		Metadata:    map[string]string{"user": d.Get("user").(string)},
+		RoleIDs:     []string{},
+	}
+
+	roleNames := convertToStringSlice(d.Get("roles").([]interface{}))
+	if len(roleNames) > 0 {
+		roleMap, err := listRoles(ctx, client)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching roles: %s", err))
+		}
+		roleIDs, err := getRoleIDs(roleMap, roleNames)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createRequest.RoleIDs = roleIDs
+	}
+
+	var response servicePasswordResponse
+	if err := fronteggRequest(ctx, client, "POST", serviceApiTokenPath, createRequest, &response); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating service app password: %s", err))
+	}
+
+	d.SetId(response.ClientID)
+	if err := setAttributes(d, map[string]interface{}{
+		"created_at":  response.CreatedAt.Format(time.RFC3339),
+		"age_seconds": int(time.Since(response.CreatedAt).Seconds()),
+		"secret":      response.Secret,
+		"password":    clients.ConstructAppPassword(response.ClientID, response.Secret),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
 // appPasswordRead reads the app password resource from the API.
 func appPasswordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta, err := utils.GetProviderMeta(meta)
@@ -114,6 +277,17 @@ func appPasswordRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
+	// Passwords created before the type was introduced are personal
+	if d.Get("type").(string) == "" {
+		if err := d.Set("type", "personal"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("type").(string) == "service" {
+		return servicePasswordRead(ctx, d, providerMeta)
+	}
+
 	client := providerMeta.Frontegg
 	resourceID := d.Id()
 
@@ -133,6 +307,7 @@ func appPasswordRead(ctx context.Context, d *schema.ResourceData, meta interface
 	// Update the Terraform state with the retrieved values.
 	d.Set("name", foundPassword.Description)
 	d.Set("created_at", foundPassword.CreatedAt.Format(time.RFC3339))
+	d.Set("age_seconds", int(time.Since(foundPassword.CreatedAt).Seconds()))
 	d.Set("secret", foundPassword.Secret)
 	d.Set("password", appPassword)
 	// TODO: Get the owner from the API as it's not returned in the response.
@@ -141,6 +316,80 @@ func appPasswordRead(ctx context.Context, d *schema.ResourceData, meta interface
 	return nil
 }
 
+// servicePasswordRead reads a tenant level app password from the API.
+func servicePasswordRead(ctx context.Context, d *schema.ResourceData, providerMeta *utils.ProviderMeta) diag.Diagnostics {
+	client := providerMeta.Frontegg
+
+	found, err := findServicePassword(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if found == nil {
+		log.Printf("[WARN] Service app password %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	roleNames := []string{}
+	if len(found.RoleIDs) > 0 {
+		roleMap, err := listRoles(ctx, client)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching roles: %s", err))
+		}
+		for name, id := range roleMap {
+			// The role map holds both the full name and the alias of a role
+			if roleAlias(name) == name && slices.Contains(found.RoleIDs, id) {
+				roleNames = append(roleNames, name)
+			}
+		}
+	}
+	configured := convertToStringSlice(d.Get("roles").([]interface{}))
+
+	if err := setAttributes(d, map[string]interface{}{
+		"name":        found.Description,
+		"user":        found.Metadata["user"],
+		"roles":       orderRoleNames(roleNames, configured),
+		"created_at":  found.CreatedAt.Format(time.RFC3339),
+		"age_seconds": int(time.Since(found.CreatedAt).Seconds()),
+		"secret":      found.Secret,
+		"password":    clients.ConstructAppPassword(found.ClientID, found.Secret),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// appPasswordUpdate only stores the new rotate_after, as every other
+// attribute replaces the app password.
+func appPasswordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return appPasswordRead(ctx, d, meta)
+}
+
+// appPasswordImport sets the type of the app password being imported, as
+// personal and service app passwords are listed by different endpoints.
+func appPasswordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := findServicePassword(ctx, providerMeta.Frontegg, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	passwordType := "personal"
+	if found != nil {
+		passwordType = "service"
+	}
+	if err := d.Set("type", passwordType); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func appPasswordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta, err := utils.GetProviderMeta(meta)
 	if err != nil {
@@ -150,6 +399,15 @@ func appPasswordDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	client := providerMeta.Frontegg
 	resourceID := d.Id()
 
+	if d.Get("type").(string) == "service" {
+		path := fmt.Sprintf("%s/%s", serviceApiTokenPath, resourceID)
+		if err := fronteggRequest(ctx, client, "DELETE", path, nil, nil); err != nil && !errors.Is(err, errFronteggNotFound) {
+			return diag.FromErr(fmt.Errorf("error deleting service app password: %s", err))
+		}
+		d.SetId("")
+		return nil
+	}
+
 	err = deleteAppPassword(ctx, client, resourceID)
 	if err != nil {
 		return diag.FromErr(err)
@@ -252,7 +510,6 @@ func listAppPasswords(ctx context.Context, client *clients.FronteggClient) ([]ap
 	if err != nil {
 		return nil, fmt.Errorf("creating request failed: %w", err)
 	}
-	req.Header.Add("Authorization", "Bearer "+client.Token)
 
 	// Execute the request
 	resp, err := client.HTTPClient.Do(req)
@@ -278,3 +535,18 @@ func listAppPasswords(ctx context.Context, client *clients.FronteggClient) ([]ap
 
 	return passwords, nil
 }
+
+// findServicePassword finds a tenant level app password by ID.
+func findServicePassword(ctx context.Context, client *clients.FronteggClient, id string) (*servicePasswordResponse, error) {
+	var passwords []servicePasswordResponse
+	if err := fronteggRequest(ctx, client, "GET", serviceApiTokenPath, nil, &passwords); err != nil {
+		return nil, fmt.Errorf("error listing service app passwords: %s", err)
+	}
+
+	for _, password := range passwords {
+		if password.ClientID == id {
+			return &password, nil
+		}
+	}
+	return nil, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,6 +70,43 @@ func TestAppPasswordResourceRead(t *testing.T) {
 	})
 }
 
+func TestAppPasswordResourceReadLegacyState(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		client := &clients.FronteggClient{
+			Endpoint:    serverURL,
+			HTTPClient:  &http.Client{},
+			TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		providerMeta := &utils.ProviderMeta{
+			Frontegg: client,
+		}
+
+		// State written before the type attribute existed
+		d := AppPassword().Data(&terraform.InstanceState{
+			ID: "mock-client-id",
+			Attributes: map[string]string{
+				"id":   "mock-client-id",
+				"name": "test-app-password",
+			},
+		})
+
+		if err := appPasswordRead(context.TODO(), d, providerMeta); err != nil {
+			t.Fatal(err)
+		}
+		r.Equal("personal", d.Get("type"))
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "test-app-password",
+		})
+		diff, err := AppPassword().Diff(context.TODO(), d.State(), config, nil)
+		r.NoError(err)
+		r.Nil(diff)
+	})
+}
+
 func TestAppPasswordResourceDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -93,3 +131,102 @@ func TestAppPasswordResourceDelete(t *testing.T) {
 		r.Empty(d.Id())
 	})
 }
+
+func TestAppPasswordResourceService(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		providerMeta := fronteggProviderMeta(serverURL)
+
+		in := map[string]interface{}{
+			"name":  "test-service-password",
+			"type":  "service",
+			"user":  "ci",
+			"roles": []interface{}{"Member", "Billing Manager"},
+		}
+		d := schema.TestResourceDataRaw(t, AppPassword().Schema, in)
+
+		diags := appPasswordCreate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Equal("mock-service-client-id", d.Id())
+		r.Equal("mock-service-secret", d.Get("secret"))
+		r.Equal("mzp_mockserviceclientidmockservicesecret", d.Get("password"))
+
+		diags = appPasswordRead(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Equal("test-service-password", d.Get("name"))
+		r.Equal("ci", d.Get("user"))
+		r.Equal([]interface{}{"Member", "Billing Manager"}, d.Get("roles"))
+
+		diags = appPasswordDelete(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestAppPasswordResourceImport(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		providerMeta := fronteggProviderMeta(serverURL)
+
+		d := schema.TestResourceDataRaw(t, AppPassword().Schema, nil)
+		d.SetId("mock-service-client-id")
+		_, err := appPasswordImport(context.TODO(), d, providerMeta)
+		r.NoError(err)
+		r.Equal("service", d.Get("type"))
+
+		d = schema.TestResourceDataRaw(t, AppPassword().Schema, nil)
+		d.SetId("mock-client-id")
+		_, err = appPasswordImport(context.TODO(), d, providerMeta)
+		r.NoError(err)
+		r.Equal("personal", d.Get("type"))
+	})
+}
+
+func TestAppPasswordResourceRotation(t *testing.T) {
+	r := require.New(t)
+
+	state := &terraform.InstanceState{
+		ID: "mock-client-id",
+		Attributes: map[string]string{
+			"id":           "mock-client-id",
+			"name":         "test-app-password",
+			"type":         "personal",
+			"rotate_after": "720h",
+			"created_at":   time.Now().Add(-24 * time.Hour).Format(time.RFC3339),
+			"secret":       "mock-secret",
+			"password":     "mzp_mockclientidmocksecret",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":         "test-app-password",
+		"rotate_after": "720h",
+	})
+
+	diff, err := AppPassword().Diff(context.TODO(), state, config, nil)
+	r.NoError(err)
+	r.Nil(diff)
+
+	// The password is replaced once it is older than rotate_after
+	state.Attributes["created_at"] = time.Now().Add(-721 * time.Hour).Format(time.RFC3339)
+	diff, err = AppPassword().Diff(context.TODO(), state, config, nil)
+	r.NoError(err)
+	r.True(diff.RequiresNew())
+}
+
+func TestAppPasswordResourceValidateType(t *testing.T) {
+	r := require.New(t)
+
+	_, err := AppPassword().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "test-service-password",
+		"type": "service",
+	}), nil)
+	r.ErrorContains(err, "user is required for service app passwords")
+
+	_, err = AppPassword().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":  "test-app-password",
+		"roles": []interface{}{"Admin"},
+	}), nil)
+	r.ErrorContains(err, "user and roles are only valid for service app passwords")
+}
@@ -47,6 +47,8 @@ func Cluster() *schema.Resource {
 		UpdateContext: clusterUpdate,
 		DeleteContext: clusterDelete,
 
+		CustomizeDiff: validateReplicaSize,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -40,6 +40,8 @@ func ClusterReplica() *schema.Resource {
 		UpdateContext: clusterReplicaUpdate,
 		DeleteContext: clusterReplicaDelete,
 
+		CustomizeDiff: validateReplicaSize,
+
 		DeprecationMessage: "Cluster replicas are deprecated. We recommend migrating to a managed cluster using the `materialize_cluster` resource and selecting `size`.",
 
 		Importer: &schema.ResourceImporter{
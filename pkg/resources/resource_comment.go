@@ -0,0 +1,295 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/exp/slices"
+)
+
+var commentSchema = map[string]*schema.Schema{
+	"object_type": {
+		Description:  fmt.Sprintf("The type of the object to comment on. One of %s.", strings.Join(commentObjectTypes, ", ")),
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(commentObjectTypes, true),
+		StateFunc: func(val any) string {
+			return strings.ToUpper(val.(string))
+		},
+	},
+	"object_name": {
+		Description: "The name of the object to comment on.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"schema_name": {
+		Description: "The schema of the object. Required for objects that belong to a schema.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"database_name": {
+		Description: "The database of the object. Required for schemas and objects that belong to a schema.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"cluster_name": {
+		Description: "The cluster of the replica. Required for cluster replicas.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"column_name": {
+		Description: "The column to comment on. Only for tables, views, materialized views and sources.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"comment": {
+		Description:  "The comment. Setting an empty comment removes it, so it must not be empty.",
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	},
+	"region": RegionSchema(),
+}
+
+func Comment() *schema.Resource {
+	return &schema.Resource{
+		Description: "A comment on an object or on a column of a relation. Comments can be set on objects that are not managed by Terraform. Do not manage the same comment with both this resource and the `comment` attribute of the object's resource: each one resets the comment to its own value, so both report a change on every plan.",
+
+		CreateContext: commentCreate,
+		ReadContext:   commentRead,
+		UpdateContext: commentUpdate,
+		DeleteContext: commentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: commentImport,
+		},
+
+		Schema: commentSchema,
+	}
+}
+
+// commentObject returns the object a comment is set on, checking that the
+// attributes qualifying the object match its type.
+func commentObject(d *schema.ResourceData) (materialize.MaterializeObject, error) {
+	o := materialize.MaterializeObject{
+		ObjectType:   strings.ToUpper(d.Get("object_type").(string)),
+		Name:         d.Get("object_name").(string),
+		SchemaName:   d.Get("schema_name").(string),
+		DatabaseName: d.Get("database_name").(string),
+		ClusterName:  d.Get("cluster_name").(string),
+	}
+
+	switch o.ObjectType {
+	case "DATABASE", "CLUSTER", "ROLE":
+		if o.SchemaName != "" || o.DatabaseName != "" || o.ClusterName != "" {
+			return o, fmt.Errorf("%s comments do not take a schema_name, database_name or cluster_name", strings.ToLower(o.ObjectType))
+		}
+	case "SCHEMA":
+		if o.DatabaseName == "" || o.SchemaName != "" || o.ClusterName != "" {
+			return o, fmt.Errorf("schema comments require database_name only")
+		}
+	case "CLUSTER REPLICA":
+		if o.ClusterName == "" || o.SchemaName != "" || o.DatabaseName != "" {
+			return o, fmt.Errorf("cluster replica comments require cluster_name only")
+		}
+	default:
+		if o.SchemaName == "" || o.DatabaseName == "" || o.ClusterName != "" {
+			return o, fmt.Errorf("%s comments require schema_name and database_name", strings.ToLower(o.ObjectType))
+		}
+	}
+
+	if d.Get("column_name").(string) != "" && !slices.Contains(commentColumnObjectTypes, o.ObjectType) {
+		return o, fmt.Errorf("column comments are only supported on %s", strings.Join(commentColumnObjectTypes, ", "))
+	}
+
+	return o, nil
+}
+
+func commentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := materialize.ScanComment(metaDb, d.Get("object_type").(string), utils.ExtractId(i), d.Get("column_name").(string))
+	if err == sql.ErrNoRows {
+		// The comment was removed, or the object was dropped
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// commentImport adopts an existing comment from an ID made of the object
+// type, the qualified name of the object and the optional column, such as
+// `aws/us-east-1:VIEW|materialize.public.view|column`.
+func commentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ie := strings.Split(utils.ExtractId(d.Id()), "|")
+	if len(ie) != 2 && len(ie) != 3 {
+		return nil, fmt.Errorf("%s cannot be parsed, expected <region>:<object_type>|<qualified_name>[|<column_name>]", d.Id())
+	}
+
+	objectType := strings.ToUpper(ie[0])
+	if !slices.Contains(commentObjectTypes, objectType) {
+		return nil, fmt.Errorf("unsupported object type %s, expected one of %s", ie[0], strings.Join(commentObjectTypes, ", "))
+	}
+
+	parts, err := materialize.ParseNameParts(ie[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse the name %s: %s", ie[1], err)
+	}
+
+	// Qualifiers of the object name, from the outermost
+	var qualifiers []string
+	switch objectType {
+	case "DATABASE", "CLUSTER", "ROLE":
+	case "SCHEMA":
+		qualifiers = []string{"database_name"}
+	case "CLUSTER REPLICA":
+		qualifiers = []string{"cluster_name"}
+	default:
+		qualifiers = []string{"database_name", "schema_name"}
+	}
+	if len(parts) != len(qualifiers)+1 {
+		return nil, fmt.Errorf("%s names have %d parts, got %s", strings.ToLower(objectType), len(qualifiers)+1, ie[1])
+	}
+
+	attributes := map[string]string{
+		"object_type": objectType,
+		"object_name": parts[len(parts)-1],
+	}
+	for i, q := range qualifiers {
+		attributes[q] = parts[i]
+	}
+	if len(ie) == 3 {
+		attributes["column_name"] = ie[2]
+	}
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	o, err := commentObject(d)
+	if err != nil {
+		return nil, err
+	}
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := materialize.ObjectId(metaDb, o)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func commentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o, err := commentObject(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setComment(d, metaDb, o); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// set id
+	i, err := materialize.ObjectId(metaDb, o)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
+	return commentRead(ctx, d, meta)
+}
+
+func commentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o, err := commentObject(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("comment") {
+		if err := setComment(d, metaDb, o); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return commentRead(ctx, d, meta)
+}
+
+func setComment(d *schema.ResourceData, metaDb *sqlx.DB, o materialize.MaterializeObject) error {
+	b := materialize.NewCommentBuilder(metaDb, o)
+	comment := d.Get("comment").(string)
+
+	if v, ok := d.GetOk("column_name"); ok {
+		return b.Column(v.(string), comment)
+	}
+	return b.Object(comment)
+}
+
+func commentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o, err := commentObject(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	b := materialize.NewCommentBuilder(metaDb, o)
+
+	if v, ok := d.GetOk("column_name"); ok {
+		err = b.RemoveColumn(v.(string))
+	} else {
+		err = b.RemoveObject()
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+var inComment = map[string]interface{}{
+	"object_type": "cluster",
+	"object_name": "cluster",
+	"comment":     "comment",
+}
+
+var inColumnComment = map[string]interface{}{
+	"object_type":   "VIEW",
+	"object_name":   "view",
+	"schema_name":   "schema",
+	"database_name": "database",
+	"column_name":   "column",
+	"comment":       "column comment",
+}
+
+func TestResourceCommentCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, inComment)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(`COMMENT ON CLUSTER "cluster" IS 'comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_clusters.name = 'cluster'`
+		testhelpers.MockClusterScan(mock, ip)
+
+		// Query Comment
+		cp := `WHERE mz_comments.id = 'u1' AND mz_comments.object_sub_id IS NULL AND mz_comments.object_type = 'cluster'`
+		testhelpers.MockCommentScan(mock, cp)
+
+		if err := commentCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u1", d.Id())
+	})
+}
+
+func TestResourceCommentColumnCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, inColumnComment)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(`COMMENT ON COLUMN "database"."schema"."view"."column" IS 'column comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		// Query Comment
+		cp := `WHERE mz_columns.name = 'column' AND mz_comments.id = 'u1' AND mz_comments.object_type = 'view'`
+		testhelpers.MockColumnCommentScan(mock, cp)
+
+		if err := commentCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("column comment", d.Get("comment"))
+	})
+}
+
+func TestResourceCommentCreateInvalidObject(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"object_type": "TABLE",
+		"object_name": "table",
+		"comment":     "comment",
+	}
+	d := schema.TestResourceDataRaw(t, Comment().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		r.NotNil(commentCreate(context.TODO(), d, db))
+	})
+
+	in = map[string]interface{}{
+		"object_type": "CLUSTER",
+		"object_name": "cluster",
+		"column_name": "column",
+		"comment":     "comment",
+	}
+	d = schema.TestResourceDataRaw(t, Comment().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		r.NotNil(commentCreate(context.TODO(), d, db))
+	})
+}
+
+func TestResourceCommentReadRemoved(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, inComment)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Comment
+		mock.ExpectQuery(`SELECT .* FROM mz_internal.mz_comments`).WillReturnRows(
+			mock.NewRows([]string{"id", "object_type", "object_sub_id", "comment"}),
+		)
+
+		if err := commentRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("", d.Id())
+	})
+}
+
+func TestResourceCommentUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, inComment)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`COMMENT ON CLUSTER "cluster" IS 'comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Comment
+		cp := `WHERE mz_comments.id = 'u1' AND mz_comments.object_sub_id IS NULL AND mz_comments.object_type = 'cluster'`
+		testhelpers.MockCommentScan(mock, cp)
+
+		if err := commentUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceCommentDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, inColumnComment)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`COMMENT ON COLUMN "database"."schema"."view"."column" IS NULL;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := commentDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceCommentImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Comment().Schema, nil)
+	d.SetId(`aws/us-east-1:view|database.schema."view"|column`)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_views.name = 'view'`
+		testhelpers.MockViewScan(mock, ip)
+
+		s, err := Comment().Importer.StateContext(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(s, 1)
+
+		// Query Comment
+		cp := `WHERE mz_columns.name = 'column' AND mz_comments.id = 'u1' AND mz_comments.object_type = 'view'`
+		testhelpers.MockColumnCommentScan(mock, cp)
+
+		if err := commentRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u1", d.Id())
+		r.Equal("VIEW", d.Get("object_type"))
+		r.Equal("view", d.Get("object_name"))
+		r.Equal("schema", d.Get("schema_name"))
+		r.Equal("database", d.Get("database_name"))
+		r.Equal("column", d.Get("column_name"))
+		r.Equal("column comment", d.Get("comment"))
+	})
+}
+
+func TestResourceCommentImportInvalid(t *testing.T) {
+	r := require.New(t)
+
+	for id, expected := range map[string]string{
+		"aws/us-east-1:u1":                     "cannot be parsed",
+		"aws/us-east-1:SEQUENCE|database.s.n":  "unsupported object type SEQUENCE",
+		"aws/us-east-1:VIEW|schema.view":       "view names have 3 parts",
+		"aws/us-east-1:CLUSTER REPLICA|r1":     "cluster replica names have 2 parts",
+		"aws/us-east-1:CLUSTER|cluster|column": "column comments are only supported on",
+	} {
+		d := schema.TestResourceDataRaw(t, Comment().Schema, nil)
+		d.SetId(id)
+
+		_, err := Comment().Importer.StateContext(context.TODO(), d, nil)
+		r.ErrorContains(err, expected, id)
+	}
+}
+
+func TestResourceCommentEmpty(t *testing.T) {
+	r := require.New(t)
+
+	// An empty comment removes the comment
+	diags := Comment().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"object_type": "CLUSTER",
+		"object_name": "cluster",
+		"comment":     "",
+	}))
+	r.True(diags.HasError())
+}
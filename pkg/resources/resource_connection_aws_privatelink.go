@@ -51,7 +51,7 @@ func ConnectionAwsPrivatelink() *schema.Resource {
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(connectionAwsPrivatelinkRead, materialize.BaseConnection, connectionAwsPrivatelinkImport),
 		},
 
 		Schema: connectionAwsPrivatelinkSchema,
@@ -211,3 +211,15 @@ func connectionAwsPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData,
 
 	return connectionAwsPrivatelinkRead(ctx, d, meta)
 }
+
+func connectionAwsPrivatelinkImport(d *schema.ResourceData, createSql string) error {
+	c, err := materialize.ParseConnectionAwsPrivatelink(createSql)
+	if err != nil {
+		return err
+	}
+
+	return setAttributes(d, map[string]interface{}{
+		"service_name":       c.ServiceName,
+		"availability_zones": c.AvailabilityZones,
+	})
+}
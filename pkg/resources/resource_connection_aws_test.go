@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var inAws = map[string]interface{}{
+	"name":                     "conn",
+	"schema_name":              "schema",
+	"database_name":            "database",
+	"aws_region":               "us-east-1",
+	"assume_role_arn":          "arn:aws:iam::123456789012:role/Materialize",
+	"assume_role_session_name": "materialize",
+}
+
+func TestResourceConnectionAwsCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionAws().Schema, inAws)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE CONNECTION "database"."schema"."conn" TO AWS
+			\(REGION = 'us-east-1',
+			ASSUME ROLE ARN = 'arn:aws:iam::123456789012:role/Materialize',
+			ASSUME ROLE SESSION NAME = 'materialize'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_connections.name = 'conn' AND mz_databases.name = 'database' AND mz_schemas.name = 'schema'`
+		testhelpers.MockConnectionScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionAwsScan(mock, pp)
+
+		if err := connectionAwsCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("mz_eb5cb59b-e2fe-41f3-87ca-d2176a495345_u1", d.Get("external_id"))
+	})
+}
+
+func TestResourceConnectionAwsUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionAws().Schema, inAws)
+
+	// Set current state
+	d.SetId("u1")
+	d.Set("name", "old_conn")
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER CONNECTION "database"."schema"."" RENAME TO "conn";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionAwsScan(mock, pp)
+
+		if err := connectionAwsUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
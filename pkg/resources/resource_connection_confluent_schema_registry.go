@@ -44,7 +44,7 @@ func ConnectionConfluentSchemaRegistry() *schema.Resource {
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(connectionRead, materialize.BaseConnection, connectionConfluentSchemaRegistryImport),
 		},
 
 		Schema: connectionConfluentSchemaRegistrySchema,
@@ -142,3 +142,21 @@ func connectionConfluentSchemaRegistryCreate(ctx context.Context, d *schema.Reso
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionConfluentSchemaRegistryImport(d *schema.ResourceData, createSql string) error {
+	c, err := materialize.ParseConnectionConfluentSchemaRegistry(createSql)
+	if err != nil {
+		return err
+	}
+
+	return setAttributes(d, map[string]interface{}{
+		"url":                       c.Url,
+		"ssl_certificate_authority": flattenValueSecret(c.SSLCa),
+		"ssl_certificate":           flattenValueSecret(c.SSLCert),
+		"ssl_key":                   flattenIdentifierSchema(c.SSLKey),
+		"username":                  flattenValueSecret(c.Username),
+		"password":                  flattenIdentifierSchema(c.Password),
+		"ssh_tunnel":                flattenIdentifierSchema(c.SSHTunnel),
+		"aws_privatelink":           flattenIdentifierSchema(c.AWSPrivateLink),
+	})
+}
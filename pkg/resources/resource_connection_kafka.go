@@ -80,6 +80,7 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 	"sasl_username":  ValueSecretSchema("sasl_username", "The SASL username for the Kafka broker.", false),
 	"sasl_password":  IdentifierSchema("sasl_password", "The SASL password for the Kafka broker.", false),
 	"ssh_tunnel":     IdentifierSchema("ssh_tunnel", "The default SSH tunnel configuration for the Kafka brokers.", false),
+	"aws_connection": IdentifierSchema("aws_connection", "The AWS connection to use for IAM authentication with Amazon MSK. Requires the `SASL_SSL` security protocol.", false),
 	"validate":       ValidateConnectionSchema(),
 	"ownership_role": OwnershipRoleSchema(),
 	"region":         RegionSchema(),
@@ -95,7 +96,7 @@ func ConnectionKafka() *schema.Resource {
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(connectionRead, materialize.BaseConnection, connectionKafkaImport),
 		},
 
 		Schema: connectionKafkaSchema,
@@ -161,6 +162,11 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 		b.KafkaSSHTunnel(conn)
 	}
 
+	if v, ok := d.GetOk("aws_connection"); ok {
+		conn := materialize.GetIdentifierSchemaStruct(v)
+		b.KafkaAwsConnection(conn)
+	}
+
 	if v, ok := d.GetOk("validate"); ok {
 		b.Validate(v.(bool))
 	}
@@ -201,3 +207,35 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionKafkaImport(d *schema.ResourceData, createSql string) error {
+	c, err := materialize.ParseConnectionKafka(createSql)
+	if err != nil {
+		return err
+	}
+
+	brokers := []interface{}{}
+	for _, b := range c.KafkaBrokers {
+		brokers = append(brokers, map[string]interface{}{
+			"broker":                 b.Broker,
+			"target_group_port":      b.TargetGroupPort,
+			"availability_zone":      b.AvailabilityZone,
+			"privatelink_connection": flattenIdentifierSchema(b.PrivateLinkConnection),
+			"ssh_tunnel":             flattenIdentifierSchema(b.SSHTunnel),
+		})
+	}
+
+	return setAttributes(d, map[string]interface{}{
+		"kafka_broker":              brokers,
+		"security_protocol":         c.SecurityProtocol,
+		"progress_topic":            c.ProgressTopic,
+		"ssl_certificate_authority": flattenValueSecret(c.SSLCa),
+		"ssl_certificate":           flattenValueSecret(c.SSLCert),
+		"ssl_key":                   flattenIdentifierSchema(c.SSLKey),
+		"sasl_mechanisms":           c.SASLMechanisms,
+		"sasl_username":             flattenValueSecret(c.SASLUsername),
+		"sasl_password":             flattenIdentifierSchema(c.SASLPassword),
+		"ssh_tunnel":                flattenIdentifierSchema(c.SSHTunnel),
+		"aws_connection":            flattenIdentifierSchema(c.AwsConnection),
+	})
+}
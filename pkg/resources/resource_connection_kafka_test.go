@@ -77,3 +77,31 @@ func TestResourceConnectionKafkaCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceConnectionKafkaImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, ConnectionKafka().Schema, map[string]interface{}{})
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_connections.id = 'u1'`
+		testhelpers.MockConnectionScan(mock, pp)
+
+		// Query Definition
+		testhelpers.MockShowCreate(mock, `CONNECTION "database"."schema"."connection"`,
+			`CREATE CONNECTION "database"."schema"."connection" TO KAFKA (BROKERS = ('b-1.hostname-1:9096' USING AWS PRIVATELINK "materialize"."public"."privatelink" (PORT = 9001)), SECURITY PROTOCOL = sasl_ssl, SASL MECHANISMS = 'PLAIN', SASL USERNAME = 'username', SASL PASSWORD = SECRET "materialize"."public"."password")`)
+
+		s, err := ConnectionKafka().Importer.StateContext(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(s, 1)
+		r.Equal("b-1.hostname-1:9096", d.Get("kafka_broker.0.broker"))
+		r.Equal(9001, d.Get("kafka_broker.0.target_group_port"))
+		r.Equal("privatelink", d.Get("kafka_broker.0.privatelink_connection.0.name"))
+		r.Equal("SASL_SSL", d.Get("security_protocol"))
+		r.Equal("PLAIN", d.Get("sasl_mechanisms"))
+		r.Equal("username", d.Get("sasl_username.0.text"))
+		r.Equal("password", d.Get("sasl_password.0.name"))
+	})
+}
@@ -64,7 +64,7 @@ func ConnectionPostgres() *schema.Resource {
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(connectionRead, materialize.BaseConnection, connectionPostgresImport),
 		},
 
 		Schema: connectionPostgresSchema,
@@ -178,3 +178,27 @@ func connectionPostgresCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	return connectionRead(ctx, d, meta)
 }
+
+func connectionPostgresImport(d *schema.ResourceData, createSql string) error {
+	c, err := materialize.ParseConnectionPostgres(createSql)
+	if err != nil {
+		return err
+	}
+	if c.Port == 0 {
+		c.Port = connectionPostgresSchema["port"].Default.(int)
+	}
+
+	return setAttributes(d, map[string]interface{}{
+		"database":                  c.Database,
+		"host":                      c.Host,
+		"port":                      c.Port,
+		"user":                      flattenValueSecret(c.User),
+		"password":                  flattenIdentifierSchema(c.Password),
+		"ssh_tunnel":                flattenIdentifierSchema(c.SSHTunnel),
+		"ssl_certificate_authority": flattenValueSecret(c.SSLCa),
+		"ssl_certificate":           flattenValueSecret(c.SSLCert),
+		"ssl_key":                   flattenIdentifierSchema(c.SSLKey),
+		"ssl_mode":                  c.SSLMode,
+		"aws_privatelink":           flattenIdentifierSchema(c.AWSPrivateLink),
+	})
+}
@@ -60,7 +60,7 @@ func ConnectionSshTunnel() *schema.Resource {
 		DeleteContext: connectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(connectionSshTunnelRead, materialize.BaseConnection, connectionSshTunnelImport),
 		},
 
 		Schema: connectionSshTunnelSchema,
@@ -212,3 +212,16 @@ func connectionSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	return connectionSshTunnelRead(ctx, d, meta)
 }
+
+func connectionSshTunnelImport(d *schema.ResourceData, createSql string) error {
+	c, err := materialize.ParseConnectionSshTunnel(createSql)
+	if err != nil {
+		return err
+	}
+
+	return setAttributes(d, map[string]interface{}{
+		"host": c.Host,
+		"user": c.User,
+		"port": c.Port,
+	})
+}
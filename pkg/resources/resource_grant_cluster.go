@@ -23,7 +23,7 @@ var grantClusterSchema = map[string]*schema.Schema{
 }
 
 func GrantCluster() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "cluster"),
 
 		CreateContext: grantClusterCreate,
@@ -35,7 +35,7 @@ func GrantCluster() *schema.Resource {
 		},
 
 		Schema: grantClusterSchema,
-	}
+	})
 }
 
 func grantClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -18,7 +18,7 @@ var grantClusterDefaultPrivilegeSchema = map[string]*schema.Schema{
 }
 
 func GrantClusterDefaultPrivilege() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: DefaultPrivilegeDefinition,
 
 		CreateContext: grantClusterDefaultPrivilegeCreate,
@@ -30,7 +30,7 @@ func GrantClusterDefaultPrivilege() *schema.Resource {
 		},
 
 		Schema: grantClusterDefaultPrivilegeSchema,
-	}
+	})
 }
 
 func grantClusterDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -35,7 +35,7 @@ var grantConnectionSchema = map[string]*schema.Schema{
 }
 
 func GrantConnection() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "connection"),
 
 		CreateContext: grantConnectionCreate,
@@ -47,7 +47,7 @@ func GrantConnection() *schema.Resource {
 		},
 
 		Schema: grantConnectionSchema,
-	}
+	})
 }
 
 func grantConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -20,7 +20,7 @@ var grantConnectionDefaultPrivilegeSchema = map[string]*schema.Schema{
 }
 
 func GrantConnectionDefaultPrivilege() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: DefaultPrivilegeDefinition,
 
 		CreateContext: grantConnectionDefaultPrivilegeCreate,
@@ -32,7 +32,7 @@ func GrantConnectionDefaultPrivilege() *schema.Resource {
 		},
 
 		Schema: grantConnectionDefaultPrivilegeSchema,
-	}
+	})
 }
 
 func grantConnectionDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -23,7 +23,7 @@ var grantDatabaseSchema = map[string]*schema.Schema{
 }
 
 func GrantDatabase() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "database"),
 
 		CreateContext: grantDatabaseCreate,
@@ -35,7 +35,7 @@ func GrantDatabase() *schema.Resource {
 		},
 
 		Schema: grantDatabaseSchema,
-	}
+	})
 }
 
 func grantDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -18,7 +18,7 @@ var grantDatabaseDefaultPrivilegeSchema = map[string]*schema.Schema{
 }
 
 func GrantDatabaseDefaultPrivilege() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: DefaultPrivilegeDefinition,
 
 		CreateContext: grantDatabaseDefaultPrivilegeCreate,
@@ -30,7 +30,7 @@ func GrantDatabaseDefaultPrivilege() *schema.Resource {
 		},
 
 		Schema: grantDatabaseDefaultPrivilegeSchema,
-	}
+	})
 }
 
 func grantDatabaseDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -35,7 +35,7 @@ var grantMaterializedViewSchema = map[string]*schema.Schema{
 }
 
 func GrantMaterializedView() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "materialized view"),
 
 		CreateContext: grantMaterializedViewCreate,
@@ -47,7 +47,7 @@ func GrantMaterializedView() *schema.Resource {
 		},
 
 		Schema: grantMaterializedViewSchema,
-	}
+	})
 }
 
 func grantMaterializedViewCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
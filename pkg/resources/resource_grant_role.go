@@ -30,7 +30,7 @@ var grantRoleSchema = map[string]*schema.Schema{
 }
 
 func GrantRole() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: "Manages the system privileges for roles.",
 
 		CreateContext: grantRoleCreate,
@@ -42,7 +42,7 @@ func GrantRole() *schema.Resource {
 		},
 
 		Schema: grantRoleSchema,
-	}
+	})
 }
 
 type RolePrivilegeKey struct {
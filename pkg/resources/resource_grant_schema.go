@@ -29,7 +29,7 @@ var grantSchemaSchema = map[string]*schema.Schema{
 }
 
 func GrantSchema() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "schema"),
 
 		CreateContext: grantSchemaCreate,
@@ -41,7 +41,7 @@ func GrantSchema() *schema.Resource {
 		},
 
 		Schema: grantSchemaSchema,
-	}
+	})
 }
 
 func grantSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -19,7 +19,7 @@ var grantSchemaDefaultPrivilegeSchema = map[string]*schema.Schema{
 }
 
 func GrantSchemaDefaultPrivilege() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: DefaultPrivilegeDefinition,
 
 		CreateContext: grantSchemaDefaultPrivilegeCreate,
@@ -31,7 +31,7 @@ func GrantSchemaDefaultPrivilege() *schema.Resource {
 		},
 
 		Schema: grantSchemaDefaultPrivilegeSchema,
-	}
+	})
 }
 
 func grantSchemaDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -35,7 +35,7 @@ var grantSecretSchema = map[string]*schema.Schema{
 }
 
 func GrantSecret() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "secret"),
 
 		CreateContext: grantSecretCreate,
@@ -47,7 +47,7 @@ func GrantSecret() *schema.Resource {
 		},
 
 		Schema: grantSecretSchema,
-	}
+	})
 }
 
 func grantSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -20,7 +20,7 @@ var grantSecretDefaultPrivilegeSchema = map[string]*schema.Schema{
 }
 
 func GrantSecretDefaultPrivilege() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: DefaultPrivilegeDefinition,
 
 		CreateContext: grantSecretDefaultPrivilegeCreate,
@@ -32,7 +32,7 @@ func GrantSecretDefaultPrivilege() *schema.Resource {
 		},
 
 		Schema: grantSecretDefaultPrivilegeSchema,
-	}
+	})
 }
 
 func grantSecretDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -35,7 +35,7 @@ var grantSourceSchema = map[string]*schema.Schema{
 }
 
 func GrantSource() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "source"),
 
 		CreateContext: grantSourceCreate,
@@ -47,7 +47,7 @@ func GrantSource() *schema.Resource {
 		},
 
 		Schema: grantSourceSchema,
-	}
+	})
 }
 
 func grantSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -27,7 +27,7 @@ var grantSystemPrivilegeSchema = map[string]*schema.Schema{
 }
 
 func GrantSystemPrivilege() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: "Manages the system privileges for roles.",
 
 		CreateContext: grantSystemPrivilegeCreate,
@@ -39,7 +39,7 @@ func GrantSystemPrivilege() *schema.Resource {
 		},
 
 		Schema: grantSystemPrivilegeSchema,
-	}
+	})
 }
 
 type SystemPrivilegeKey struct {
@@ -35,7 +35,7 @@ var grantTableSchema = map[string]*schema.Schema{
 }
 
 func GrantTable() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "table"),
 
 		CreateContext: grantTableCreate,
@@ -47,7 +47,7 @@ func GrantTable() *schema.Resource {
 		},
 
 		Schema: grantTableSchema,
-	}
+	})
 }
 
 func grantTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
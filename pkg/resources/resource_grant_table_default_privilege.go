@@ -20,7 +20,7 @@ var grantTableDefaultPrivilegeSchema = map[string]*schema.Schema{
 }
 
 func GrantTableDefaultPrivilege() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: DefaultPrivilegeDefinition,
 
 		CreateContext: grantTableDefaultPrivilegeCreate,
@@ -32,7 +32,7 @@ func GrantTableDefaultPrivilege() *schema.Resource {
 		},
 
 		Schema: grantTableDefaultPrivilegeSchema,
-	}
+	})
 }
 
 func grantTableDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -35,7 +35,7 @@ var grantTypeSchema = map[string]*schema.Schema{
 }
 
 func GrantType() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: fmt.Sprintf(GrantDefinition, "type"),
 
 		CreateContext: grantTypeCreate,
@@ -47,7 +47,7 @@ func GrantType() *schema.Resource {
 		},
 
 		Schema: grantTypeSchema,
-	}
+	})
 }
 
 func grantTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
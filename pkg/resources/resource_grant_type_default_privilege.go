@@ -20,7 +20,7 @@ var grantTypeDefaultPrivilegeSchema = map[string]*schema.Schema{
 }
 
 func GrantTypeDefaultPrivilege() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: DefaultPrivilegeDefinition,
 
 		CreateContext: grantTypeDefaultPrivilegeCreate,
@@ -32,7 +32,7 @@ func GrantTypeDefaultPrivilege() *schema.Resource {
 		},
 
 		Schema: grantTypeDefaultPrivilegeSchema,
-	}
+	})
 }
 
 func grantTypeDefaultPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -34,7 +34,7 @@ var grantViewSchema = map[string]*schema.Schema{
 }
 
 func GrantView() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: "Manages the privileges on a Materailize view for roles.",
 
 		CreateContext: grantViewCreate,
@@ -46,7 +46,7 @@ func GrantView() *schema.Resource {
 		},
 
 		Schema: grantViewSchema,
-	}
+	})
 }
 
 func grantViewCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
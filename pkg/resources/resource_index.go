@@ -3,7 +3,10 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"strings"
+	"unicode"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -11,13 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/exp/slices"
 )
 
 var indexSchema = map[string]*schema.Schema{
 	"name": {
-		Description:  "The identifier for the index.",
+		Description:  "The identifier for the index. Computed for default indexes.",
 		Type:         schema.TypeString,
 		Optional:     true,
+		Computed:     true,
 		ForceNew:     true,
 		ExactlyOneOf: []string{"name", "default"},
 	},
@@ -42,10 +48,9 @@ var indexSchema = map[string]*schema.Schema{
 	"comment":            CommentSchema(false),
 	"obj_name":           IdentifierSchema("obj_name", "The name of the source, view, or materialized view on which you want to create an index.", true),
 	"cluster_name": {
-		Description: "The cluster to maintain this index.",
+		Description: "The cluster to maintain this index. Changing the cluster creates the index on the new cluster before dropping the index on the old cluster.",
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 	},
 	"method": {
 		Description:  "The name of the index method to use.",
@@ -56,18 +61,19 @@ var indexSchema = map[string]*schema.Schema{
 		ValidateFunc: validation.StringInSlice([]string{"ARRANGEMENT"}, true),
 	},
 	"col_expr": {
-		Description: "The expressions to use as the key for the index.",
+		Description: "The expressions to use as the key for the index. Required unless `default` is set, in which case the inferred key is read back.",
 		Type:        schema.TypeList,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"field": {
-					Description: "The name of the option you want to set.",
+					Description: "The column or expression to use as part of the key.",
 					Type:        schema.TypeString,
 					Required:    true,
 				},
 			},
 		},
-		Required: true,
+		Optional: true,
+		Computed: true,
 		ForceNew: true,
 	},
 	"region": RegionSchema(),
@@ -83,7 +89,7 @@ func Index() *schema.Resource {
 		DeleteContext: indexDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(indexRead, materialize.Index, indexImport),
 		},
 
 		Schema: indexSchema,
@@ -128,48 +134,74 @@ func indexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		return diag.FromErr(err)
 	}
 
-	// Index columns
-	indexColumns, err := materialize.ListIndexColumns(metaDb, utils.ExtractId(i))
+	if err := d.Set("cluster_name", s.ClusterName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Index key
+	keys, err := materialize.ListIndexKeys(metaDb, utils.ExtractId(i))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if len(indexColumns) > 0 {
-		var ic []interface{}
-		for _, i := range indexColumns {
-			column := map[string]interface{}{"field": i.Name.String}
-			ic = append(ic, column)
-		}
-		if err := d.Set("col_expr", ic); err != nil {
-			return diag.FromErr(err)
-		}
+	if err := d.Set("col_expr", flattenIndexKeys(keys, d.Get("col_expr").([]interface{}))); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// flattenIndexKeys returns the key of an index as col_expr, keeping the
+// configured expressions when they only differ from the catalog in quoting,
+// case or whitespace.
+func flattenIndexKeys(keys []materialize.IndexKeyParams, prior []interface{}) []interface{} {
+	var ic []interface{}
+	for _, k := range keys {
+		ic = append(ic, map[string]interface{}{"field": k.Expression.String})
+	}
+
+	if len(prior) != len(ic) {
+		return ic
+	}
+	for n, p := range prior {
+		field, ok := p.(map[string]interface{})["field"].(string)
+		if !ok || normalizeIndexExpression(field) != normalizeIndexExpression(ic[n].(map[string]interface{})["field"].(string)) {
+			return ic
+		}
+	}
+	return prior
+}
+
+func normalizeIndexExpression(e string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '"' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, e)
+}
+
 func indexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	indexName := d.Get("name").(string)
 	indexDefault := d.Get("default").(bool)
 
-	obj := d.Get("obj_name").([]interface{})[0].(map[string]interface{})
+	obj := materialize.GetIdentifierSchemaStruct(d.Get("obj_name"))
 
 	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// Default indexes are named by Materialize
+	var existing []materialize.IndexParams
+	if indexDefault {
+		if existing, err = materialize.ListObjectIndexes(metaDb, obj); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	o := materialize.MaterializeObject{ObjectType: "INDEX", Name: indexName}
-	b := materialize.NewIndexBuilder(
-		metaDb,
-		o,
-		indexDefault,
-		materialize.IdentifierSchemaStruct{
-			Name:         obj["name"].(string),
-			SchemaName:   obj["schema_name"].(string),
-			DatabaseName: obj["database_name"].(string),
-		},
-	)
+	b := materialize.NewIndexBuilder(metaDb, o, indexDefault, obj)
 
 	if v, ok := d.GetOk("cluster_name"); ok {
 		b.ClusterName(v.(string))
@@ -189,39 +221,66 @@ func indexCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
+	// set id
+	var i string
+	if indexDefault {
+		indexes, err := materialize.ListObjectIndexes(metaDb, obj)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		n := newIndexes(existing, indexes)
+		if len(n) != 1 {
+			return diag.Errorf("found %d default indexes created for %s, expected 1", len(n), obj.QualifiedName())
+		}
+		i = n[0].IndexId.String
+		b = materialize.NewIndexBuilder(metaDb, materialize.MaterializeObject{Name: n[0].IndexName.String}, false, obj)
+	} else if i, err = materialize.IndexId(metaDb, indexObject(indexName, obj)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
 	// object comment
 	if v, ok := d.GetOk("comment"); ok {
 		if err := b.Comment(v.(string)); err != nil {
 			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
 			b.Drop()
+			d.SetId("")
 			return diag.FromErr(err)
 		}
 	}
 
-	// set id
-	i, err := materialize.IndexId(metaDb, indexName)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	d.SetId(utils.TransformIdWithRegion(string(region), i))
-
 	return indexRead(ctx, d, meta)
 }
 
 func indexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	indexName := d.Get("name").(string)
-	o := materialize.MaterializeObject{ObjectType: "INDEX", Name: indexName}
+	obj := materialize.GetIdentifierSchemaStruct(d.Get("obj_name"))
 
-	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if d.HasChange("comment") {
+	o := materialize.MaterializeObject{ObjectType: "INDEX", Name: indexName}
+
+	if d.HasChange("cluster_name") {
+		if err := indexMoveCluster(metaDb, string(region), d, obj); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The comment does not carry over to the new index
+		if v, ok := d.GetOk("comment"); ok {
+			b := materialize.NewIndexBuilder(metaDb, o, false, obj)
+
+			if err := b.Comment(v.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	} else if d.HasChange("comment") {
 		_, newComment := d.GetChange("comment")
-		b := materialize.NewCommentBuilder(metaDb, o)
+		b := materialize.NewIndexBuilder(metaDb, o, false, obj)
 
-		if err := b.Object(newComment.(string)); err != nil {
+		if err := b.Comment(newComment.(string)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -229,6 +288,119 @@ func indexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return indexRead(ctx, d, meta)
 }
 
+// indexMoveCluster creates the index on the new cluster before dropping the
+// index on the old cluster, so the indexed object stays queryable, and
+// points the resource to the new index.
+func indexMoveCluster(metaDb *sqlx.DB, region string, d *schema.ResourceData, obj materialize.IdentifierSchemaStruct) error {
+	indexName := d.Get("name").(string)
+	indexDefault := d.Get("default").(bool)
+	_, newCluster := d.GetChange("cluster_name")
+
+	var existing []materialize.IndexParams
+	if indexDefault {
+		var err error
+		if existing, err = materialize.ListObjectIndexes(metaDb, obj); err != nil {
+			return err
+		}
+	}
+
+	o := materialize.MaterializeObject{ObjectType: "INDEX", Name: indexName + "_tmp"}
+	b := materialize.NewIndexBuilder(metaDb, o, indexDefault, obj)
+	b.ClusterName(newCluster.(string))
+
+	if v, ok := d.GetOk("method"); ok {
+		b.Method(v.(string))
+	}
+
+	if v, ok := d.GetOk("col_expr"); ok {
+		b.ColExpr(materialize.GetIndexColumnStruct(v.([]interface{})))
+	}
+
+	if err := b.Create(); err != nil {
+		return err
+	}
+
+	var n materialize.IndexParams
+	if indexDefault {
+		var err error
+		if n, err = newDefaultIndex(metaDb, existing, obj, utils.ExtractId(d.Id()), newCluster.(string)); err != nil {
+			return err
+		}
+	} else {
+		i, err := materialize.IndexId(metaDb, indexObject(o.Name, obj))
+		if err != nil {
+			return err
+		}
+		n.IndexId.String, n.IndexName.String = i, o.Name
+	}
+
+	nb := materialize.NewIndexBuilder(metaDb, materialize.MaterializeObject{Name: n.IndexName.String}, false, obj)
+
+	if err := materialize.NewIndexBuilder(metaDb, materialize.MaterializeObject{Name: indexName}, false, obj).Drop(); err != nil {
+		log.Printf("[DEBUG] resource failed to drop the index on the old cluster, dropping new index: %s", n.IndexName.String)
+		nb.Drop()
+		return err
+	}
+
+	// The old index is gone, so the resource tracks the new index even if
+	// the rename fails
+	d.SetId(utils.TransformIdWithRegion(region, n.IndexId.String))
+
+	return nb.Rename(indexName)
+}
+
+// newDefaultIndex returns the default index created on the cluster. Default
+// indexes are named by Materialize, so it is looked up among the indexes
+// missing from existing, on the cluster and with the key of the index it
+// replaces.
+func newDefaultIndex(metaDb *sqlx.DB, existing []materialize.IndexParams, obj materialize.IdentifierSchemaStruct, oldId, cluster string) (materialize.IndexParams, error) {
+	indexes, err := materialize.ListObjectIndexes(metaDb, obj)
+	if err != nil {
+		return materialize.IndexParams{}, err
+	}
+
+	key, err := materialize.ListIndexKeys(metaDb, oldId)
+	if err != nil {
+		return materialize.IndexParams{}, err
+	}
+
+	var candidates []materialize.IndexParams
+	for _, i := range newIndexes(existing, indexes) {
+		if i.ClusterName.String != cluster {
+			continue
+		}
+		k, err := materialize.ListIndexKeys(metaDb, i.IndexId.String)
+		if err != nil {
+			return materialize.IndexParams{}, err
+		}
+		if slices.Equal(k, key) {
+			candidates = append(candidates, i)
+		}
+	}
+
+	if len(candidates) != 1 {
+		return materialize.IndexParams{}, fmt.Errorf("found %d default indexes created on cluster %s for %s, expected 1", len(candidates), cluster, obj.QualifiedName())
+	}
+	return candidates[0], nil
+}
+
+// indexObject returns an index, which belongs to the schema of the indexed
+// object.
+func indexObject(name string, obj materialize.IdentifierSchemaStruct) materialize.MaterializeObject {
+	return materialize.MaterializeObject{ObjectType: "INDEX", Name: name, SchemaName: obj.SchemaName, DatabaseName: obj.DatabaseName}
+}
+
+// newIndexes returns the indexes missing from existing.
+func newIndexes(existing, indexes []materialize.IndexParams) []materialize.IndexParams {
+	var n []materialize.IndexParams
+	for _, i := range indexes {
+		if !slices.ContainsFunc(existing, func(e materialize.IndexParams) bool { return e.IndexId == i.IndexId }) {
+			n = append(n, i)
+		}
+	}
+	return n
+}
+
 func indexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	obj := d.Get("obj_name").([]interface{})[0].(map[string]interface{})
 	name := d.Get("name").(string)
@@ -255,3 +427,20 @@ func indexDelete(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 	return nil
 }
+
+func indexImport(d *schema.ResourceData, createSql string) error {
+	i, err := materialize.ParseIndex(createSql)
+	if err != nil {
+		return err
+	}
+	if i.Method == "" {
+		i.Method = indexSchema["method"].Default.(string)
+	}
+
+	return setAttributes(d, map[string]interface{}{
+		"default":      i.Default,
+		"obj_name":     flattenIdentifierSchema(i.ObjName),
+		"cluster_name": i.ClusterName,
+		"method":       i.Method,
+	})
+}
@@ -2,8 +2,10 @@ package resources
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
@@ -31,16 +33,16 @@ func TestResourceIndexCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		// Query Id
-		ip := `WHERE mz_indexes.name = 'index' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		ip := `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
 		testhelpers.MockIndexScan(mock, ip)
 
 		// Query Params
 		pp := `WHERE mz_indexes.id = 'u1' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
 		testhelpers.MockIndexScan(mock, pp)
 
-		// Query Columns
-		cp := `WHERE mz_indexes.id = 'u1'`
-		testhelpers.MockIndexColumnScan(mock, cp)
+		// Query Keys
+		kp := `WHERE mz_index_columns.index_id = 'u1'`
+		testhelpers.MockIndexKeyScan(mock, kp)
 
 		if err := indexCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -48,6 +50,48 @@ func TestResourceIndexCreate(t *testing.T) {
 	})
 }
 
+func TestResourceIndexDefaultCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"default":      true,
+		"obj_name":     []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"cluster_name": "cluster",
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Existing Indexes
+		op := `WHERE mz_databases.name = 'database' AND mz_objects.name = 'source' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
+		testhelpers.MockObjectIndexScan(mock, op, map[string]string{"u1": "index"})
+
+		// Create
+		mock.ExpectExec(
+			`CREATE DEFAULT INDEX IN CLUSTER cluster ON "database"."schema"."source" USING ARRANGEMENT \(\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		testhelpers.MockObjectIndexScan(mock, op, map[string]string{"u1": "index", "u2": "source_primary_idx"})
+
+		// Query Params
+		pp := `WHERE mz_indexes.id = 'u2' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, pp)
+
+		// Query Keys
+		kp := `WHERE mz_index_columns.index_id = 'u2'`
+		testhelpers.MockIndexKeyScan(mock, kp)
+
+		if err := indexCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u2", d.Id())
+		r.Equal("column", d.Get("col_expr.0.field"))
+		r.Equal("lower(name)", d.Get("col_expr.1.field"))
+	})
+}
+
 // Confirm id is updated with region for 0.4.0
 func TestResourceIndexReadIdMigration(t *testing.T) {
 	r := require.New(t)
@@ -66,9 +110,9 @@ func TestResourceIndexReadIdMigration(t *testing.T) {
 		pp := `WHERE mz_indexes.id = 'u1' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
 		testhelpers.MockIndexScan(mock, pp)
 
-		// Query Columns
-		cp := `WHERE mz_indexes.id = 'u1'`
-		testhelpers.MockIndexColumnScan(mock, cp)
+		// Query Keys
+		kp := `WHERE mz_index_columns.index_id = 'u1'`
+		testhelpers.MockIndexKeyScan(mock, kp)
 
 		if err := indexRead(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
@@ -80,6 +124,213 @@ func TestResourceIndexReadIdMigration(t *testing.T) {
 	})
 }
 
+func TestResourceIndexReadKeepsColExpr(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":     "index",
+		"col_expr": []interface{}{map[string]interface{}{"field": `"column"`}, map[string]interface{}{"field": "LOWER( name )"}},
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_indexes.id = 'u1' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, pp)
+
+		// Query Keys
+		kp := `WHERE mz_index_columns.index_id = 'u1'`
+		testhelpers.MockIndexKeyScan(mock, kp)
+
+		if err := indexRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("cluster", d.Get("cluster_name"))
+		r.Equal(`"column"`, d.Get("col_expr.0.field"))
+		r.Equal("LOWER( name )", d.Get("col_expr.1.field"))
+	})
+}
+
+func TestResourceIndexUpdateCluster(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "index",
+		"default":      false,
+		"obj_name":     []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"cluster_name": "new_cluster",
+		"col_expr":     []interface{}{map[string]interface{}{"field": "column"}},
+		"comment":      "comment",
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create on new cluster
+		mock.ExpectExec(
+			`CREATE INDEX index_tmp IN CLUSTER new_cluster ON "database"."schema"."source" USING ARRANGEMENT \(column\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query New Index
+		ip := `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index_tmp' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
+		testhelpers.MockObjectIndexScan(mock, ip, map[string]string{"u2": "index_tmp"})
+
+		// Drop old index and take over its name
+		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index_tmp" RENAME TO "index";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`COMMENT ON INDEX "database"."schema"."index" IS 'comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_indexes.id = 'u2' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, pp)
+
+		// Query Keys
+		kp := `WHERE mz_index_columns.index_id = 'u2'`
+		testhelpers.MockIndexKeyScan(mock, kp)
+
+		if err := indexUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u2", d.Id())
+	})
+}
+
+func TestResourceIndexUpdateClusterDropsNewIndexOnFailure(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "index",
+		"default":      false,
+		"obj_name":     []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"cluster_name": "new_cluster",
+		"col_expr":     []interface{}{map[string]interface{}{"field": "column"}},
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE INDEX index_tmp IN CLUSTER new_cluster ON "database"."schema"."source" USING ARRANGEMENT \(column\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		ip := `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index_tmp' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
+		testhelpers.MockObjectIndexScan(mock, ip, map[string]string{"u2": "index_tmp"})
+
+		// Old index is still in use
+		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnError(sql.ErrConnDone)
+		mock.ExpectExec(`DROP INDEX "database"."schema"."index_tmp" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		r.NotNil(indexUpdate(context.TODO(), d, db))
+		r.Equal("u1", d.Id())
+	})
+}
+
+func TestResourceIndexUpdateClusterRenameFailure(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "index",
+		"default":      false,
+		"obj_name":     []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"cluster_name": "new_cluster",
+		"col_expr":     []interface{}{map[string]interface{}{"field": "column"}},
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`CREATE INDEX index_tmp IN CLUSTER new_cluster ON "database"."schema"."source" USING ARRANGEMENT \(column\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		ip := `WHERE mz_databases.name = 'database' AND mz_indexes.name = 'index_tmp' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
+		testhelpers.MockObjectIndexScan(mock, ip, map[string]string{"u2": "index_tmp"})
+		mock.ExpectExec(`DROP INDEX "database"."schema"."index" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."index_tmp" RENAME TO "index";`).WillReturnError(sql.ErrConnDone)
+
+		r.NotNil(indexUpdate(context.TODO(), d, db))
+
+		// The old index was dropped, so the state points to the new index
+		r.Equal("aws/us-east-1:u2", d.Id())
+	})
+}
+
+func TestResourceIndexUpdateClusterDefault(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":         "source_primary_idx",
+		"default":      true,
+		"obj_name":     []interface{}{map[string]interface{}{"name": "source", "schema_name": "schema", "database_name": "database"}},
+		"cluster_name": "new_cluster",
+	}
+	d := schema.TestResourceDataRaw(t, Index().Schema, in)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Existing Indexes
+		op := `WHERE mz_databases.name = 'database' AND mz_objects.name = 'source' AND mz_objects.type IN \('source', 'view', 'materialized-view'\) AND mz_schemas.name = 'schema'`
+		testhelpers.MockObjectIndexClusterScan(mock, op, [3]string{"u1", "source_primary_idx", "cluster"})
+
+		// Create on new cluster
+		mock.ExpectExec(
+			`CREATE DEFAULT INDEX IN CLUSTER new_cluster ON "database"."schema"."source" USING ARRANGEMENT \(\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query New Index, among indexes created concurrently on other
+		// clusters or with another key
+		testhelpers.MockObjectIndexClusterScan(mock, op,
+			[3]string{"u1", "source_primary_idx", "cluster"},
+			[3]string{"u2", "source_idx", "other_cluster"},
+			[3]string{"u3", "source_name_idx", "new_cluster"},
+			[3]string{"u4", "source_primary_idx1", "new_cluster"},
+		)
+		testhelpers.MockIndexKeyScan(mock, `WHERE mz_index_columns.index_id = 'u1'`)
+		testhelpers.MockIndexKeysScan(mock, `WHERE mz_index_columns.index_id = 'u3'`, "name")
+		testhelpers.MockIndexKeyScan(mock, `WHERE mz_index_columns.index_id = 'u4'`)
+
+		// Drop old index and take over its name
+		mock.ExpectExec(`DROP INDEX "database"."schema"."source_primary_idx" RESTRICT;`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER INDEX "database"."schema"."source_primary_idx1" RENAME TO "source_primary_idx";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_indexes.id = 'u4' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, pp)
+
+		// Query Keys
+		kp := `WHERE mz_index_columns.index_id = 'u4'`
+		testhelpers.MockIndexKeyScan(mock, kp)
+
+		if err := indexUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u4", d.Id())
+	})
+}
+
+func TestFlattenIndexKeys(t *testing.T) {
+	r := require.New(t)
+
+	keys := []materialize.IndexKeyParams{
+		{Expression: sql.NullString{String: "a", Valid: true}},
+		{Expression: sql.NullString{String: "lower(b)", Valid: true}},
+	}
+
+	prior := []interface{}{map[string]interface{}{"field": `"a"`}, map[string]interface{}{"field": "LOWER(b)"}}
+	r.Equal(prior, flattenIndexKeys(keys, prior))
+
+	prior = []interface{}{map[string]interface{}{"field": "a"}, map[string]interface{}{"field": "upper(b)"}}
+	r.Equal([]interface{}{map[string]interface{}{"field": "a"}, map[string]interface{}{"field": "lower(b)"}}, flattenIndexKeys(keys, prior))
+	r.Len(flattenIndexKeys(keys, nil), 2)
+}
+
 func TestResourceIndexDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -99,3 +350,34 @@ func TestResourceIndexDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceIndexImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Index().Schema, map[string]interface{}{})
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_indexes.id = 'u1' AND mz_objects.type IN \('source', 'view', 'materialized-view'\)`
+		testhelpers.MockIndexScan(mock, pp)
+
+		// Query Keys
+		kp := `WHERE mz_index_columns.index_id = 'u1'`
+		testhelpers.MockIndexKeyScan(mock, kp)
+
+		// Query Definition
+		testhelpers.MockShowCreate(mock, `INDEX "database"."schema"."index"`,
+			`CREATE INDEX "index" IN CLUSTER "cluster" ON "database"."schema"."obj" USING arrangement ("column")`)
+
+		s, err := Index().Importer.StateContext(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(s, 1)
+		r.Equal(false, d.Get("default"))
+		r.Equal("cluster", d.Get("cluster_name"))
+		r.Equal("ARRANGEMENT", d.Get("method"))
+		r.Equal("obj", d.Get("obj_name.0.name"))
+		r.Equal("schema", d.Get("obj_name.0.schema_name"))
+		r.Equal("database", d.Get("obj_name.0.database_name"))
+	})
+}
@@ -58,7 +58,7 @@ func MaterializedView() *schema.Resource {
 		DeleteContext: materializedViewDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(materializedViewRead, materialize.MaterializedView, materializedViewImport),
 		},
 
 		Schema: materializedViewSchema,
@@ -238,3 +238,15 @@ func materializedViewDelete(ctx context.Context, d *schema.ResourceData, meta an
 	}
 	return nil
 }
+
+func materializedViewImport(d *schema.ResourceData, createSql string) error {
+	m, err := materialize.ParseMaterializedView(createSql)
+	if err != nil {
+		return err
+	}
+
+	return setAttributes(d, map[string]interface{}{
+		"not_null_assertion": m.NotNullAssertions,
+		"statement":          m.Statement,
+	})
+}
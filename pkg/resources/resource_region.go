@@ -0,0 +1,183 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Region() *schema.Resource {
+	return &schema.Resource{
+		Description: "Enables a Materialize region. The region is enabled once its SQL address can be resolved.",
+
+		CreateContext: regionCreate,
+		ReadContext:   regionRead,
+		DeleteContext: regionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the region to enable, such as `aws/us-east-1`.",
+			},
+			"sql_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the SQL endpoint of the region.",
+			},
+			"http_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the HTTP endpoint of the region.",
+			},
+			"resolvable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the addresses of the region can be resolved.",
+			},
+			"enabled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the region was enabled.",
+			},
+		},
+	}
+}
+
+func regionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := providerMeta.CloudAPI
+	regionID := d.Get("region").(string)
+
+	provider, err := client.GetProvider(ctx, regionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.EnableRegion(ctx, *provider); err != nil {
+		return diag.FromErr(fmt.Errorf("error enabling region %s: %s", regionID, err))
+	}
+	d.SetId(regionID)
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		region, err := client.GetRegionDetails(ctx, *provider)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if region.RegionInfo == nil || !region.RegionInfo.Resolvable {
+			log.Printf("[DEBUG] Waiting for region %s to be resolvable", regionID)
+			return retry.RetryableError(fmt.Errorf("region %s is not resolvable yet", regionID))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for region %s to be enabled: %s", regionID, err))
+	}
+
+	return regionRead(ctx, d, meta)
+}
+
+func regionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := providerMeta.CloudAPI
+	regionID := d.Id()
+
+	provider, err := client.GetProvider(ctx, regionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region, err := client.GetRegionDetails(ctx, *provider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if region.RegionInfo == nil {
+		log.Printf("[WARN] Region %s is not enabled, removing from state", regionID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("region", regionID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("sql_address", region.RegionInfo.SqlAddress); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("http_address", region.RegionInfo.HttpAddress); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("resolvable", region.RegionInfo.Resolvable); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("enabled_at", region.RegionInfo.EnabledAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func regionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client := providerMeta.CloudAPI
+	regionID := d.Id()
+
+	provider, err := client.GetProvider(ctx, regionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.DisableRegion(ctx, *provider); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling region %s: %s", regionID, err))
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		region, err := client.GetRegionDetails(ctx, *provider)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if region.RegionInfo != nil {
+			log.Printf("[DEBUG] Waiting for region %s to be disabled", regionID)
+			return retry.RetryableError(fmt.Errorf("region %s is still enabled", regionID))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for region %s to be disabled: %s", regionID, err))
+	}
+
+	providerMeta.MarkRegionDisabled(clients.Region(regionID))
+
+	d.SetId("")
+	return nil
+}
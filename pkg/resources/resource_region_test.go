@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func regionProviderMeta(serverURL string) *utils.ProviderMeta {
+	fronteggClient := &clients.FronteggClient{
+		Endpoint:    serverURL,
+		HTTPClient:  &http.Client{},
+		TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	return &utils.ProviderMeta{
+		Frontegg:       fronteggClient,
+		CloudAPI:       clients.NewCloudAPIClient(fronteggClient, serverURL),
+		RegionsEnabled: map[clients.Region]bool{},
+	}
+}
+
+func TestRegionResourceCreateDelete(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockCloudRegionServer(t, func(serverURL string) {
+		providerMeta := regionProviderMeta(serverURL)
+
+		in := map[string]interface{}{
+			"region": "aws/us-east-1",
+		}
+		d := schema.TestResourceDataRaw(t, Region().Schema, in)
+		r.NotNil(d)
+
+		diags := regionCreate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Equal("aws/us-east-1", d.Id())
+		r.Equal("sql.materialize.com", d.Get("sql_address"))
+		r.Equal("http.materialize.com", d.Get("http_address"))
+		r.Equal(true, d.Get("resolvable"))
+		r.Equal("2021-01-01T00:00:00Z", d.Get("enabled_at"))
+
+		diags = regionDelete(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Equal("", d.Id())
+		r.False(providerMeta.RegionsEnabled[clients.AwsUsEast1])
+	})
+}
+
+func TestRegionResourceDeleteConcurrentClients(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockCloudRegionServer(t, func(serverURL string) {
+		providerMeta := regionProviderMeta(serverURL)
+
+		d := schema.TestResourceDataRaw(t, Region().Schema, map[string]interface{}{"region": "aws/us-east-1"})
+		diags := regionCreate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+
+		db, _, err := sqlmock.New()
+		r.NoError(err)
+		providerMeta.DB = map[clients.Region]*clients.DBClient{
+			clients.AwsUsEast1: {DB: sqlx.NewDb(db, "sqlmock")},
+		}
+		providerMeta.RegionsEnabled[clients.AwsUsEast1] = true
+
+		// Resources of the region are refreshed while it is destroyed
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 10; j++ {
+					utils.GetDBClientForRegion(providerMeta, clients.AwsUsEast1)
+				}
+			}()
+		}
+
+		diags = regionDelete(context.TODO(), d, providerMeta)
+		wg.Wait()
+		r.False(diags.HasError(), diags)
+		r.False(providerMeta.RegionsEnabled[clients.AwsUsEast1])
+		r.NotContains(providerMeta.DB, clients.AwsUsEast1)
+	})
+}
+
+func TestRegionResourceReadDisabled(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockCloudRegionServer(t, func(serverURL string) {
+		providerMeta := regionProviderMeta(serverURL)
+
+		d := schema.TestResourceDataRaw(t, Region().Schema, nil)
+		d.SetId("aws/us-east-1")
+
+		diags := regionRead(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Equal("", d.Id())
+	})
+}
+
+func TestRegionResourceCreateUnknownRegion(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockCloudRegionServer(t, func(serverURL string) {
+		providerMeta := regionProviderMeta(serverURL)
+
+		in := map[string]interface{}{
+			"region": "aws/ap-south-1",
+		}
+		d := schema.TestResourceDataRaw(t, Region().Schema, in)
+
+		diags := regionCreate(context.TODO(), d, providerMeta)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "provider for region 'aws/ap-south-1' not found")
+	})
+}
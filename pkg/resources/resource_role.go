@@ -25,7 +25,7 @@ var roleSchema = map[string]*schema.Schema{
 }
 
 func Role() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: "A role is a collection of privileges you can apply to users.",
 
 		CreateContext: roleCreate,
@@ -38,7 +38,7 @@ func Role() *schema.Resource {
 		},
 
 		Schema: roleSchema,
-	}
+	})
 }
 
 func roleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
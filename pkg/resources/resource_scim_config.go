@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func SCIMConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "A SCIM 2.0 configuration to provision users and groups from an identity provider. The token to configure in the identity provider is only returned on create.",
+
+		CreateContext: scimConfigCreate,
+		ReadContext:   scimConfigRead,
+		DeleteContext: scimConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"okta", "azure-ad", "other"}, false),
+				Description:  "The identity provider that provisions users. Allowed values are 'okta', 'azure-ad' and 'other'.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the SCIM connection.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The bearer token the identity provider authenticates with. It is not available after an import.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the organization.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the configuration was created.",
+			},
+		},
+	}
+}
+
+// SCIMConfigRequest is used to serialize the request body for creating a SCIM configuration.
+type SCIMConfigRequest struct {
+	Source         string `json:"source"`
+	ConnectionName string `json:"connectionName"`
+}
+
+// SCIMConfigResponse represents a SCIM configuration in the Frontegg API.
+type SCIMConfigResponse struct {
+	ID             string `json:"id"`
+	Source         string `json:"source"`
+	TenantID       string `json:"tenantId"`
+	ConnectionName string `json:"connectionName"`
+	CreatedAt      string `json:"createdAt"`
+	Token          string `json:"token"`
+}
+
+func scimConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := SCIMConfigRequest{
+		Source:         d.Get("source").(string),
+		ConnectionName: d.Get("connection_name").(string),
+	}
+
+	var config SCIMConfigResponse
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "POST", scimConfigurationsPath, body, &config); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating SCIM configuration: %s", err))
+	}
+
+	d.SetId(config.ID)
+	if err := d.Set("token", config.Token); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return scimConfigRead(ctx, d, meta)
+}
+
+func scimConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API only lists configurations
+	var configs []SCIMConfigResponse
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "GET", scimConfigurationsPath, nil, &configs); err != nil {
+		return diag.FromErr(fmt.Errorf("error listing SCIM configurations: %s", err))
+	}
+
+	for _, config := range configs {
+		if config.ID != d.Id() {
+			continue
+		}
+
+		if err := setAttributes(d, map[string]interface{}{
+			"source":          config.Source,
+			"connection_name": config.ConnectionName,
+			"tenant_id":       config.TenantID,
+			"created_at":      config.CreatedAt,
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] SCIM configuration %s not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func scimConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := fmt.Sprintf("%s/%s", scimConfigurationsPath, d.Id())
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "DELETE", path, nil, nil); err != nil && !errors.Is(err, errFronteggNotFound) {
+		return diag.FromErr(fmt.Errorf("error deleting SCIM configuration: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSCIMConfigResource(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		providerMeta := fronteggProviderMeta(serverURL)
+
+		in := map[string]interface{}{
+			"source":          "okta",
+			"connection_name": "okta-scim",
+		}
+		d := schema.TestResourceDataRaw(t, SCIMConfig().Schema, in)
+
+		diags := scimConfigCreate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.NotEmpty(d.Id())
+		r.Equal("mock-scim-token", d.Get("token"))
+		r.Equal("mock-tenant-id", d.Get("tenant_id"))
+
+		// The token is kept on read
+		diags = scimConfigRead(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Equal("mock-scim-token", d.Get("token"))
+		r.Equal("okta-scim", d.Get("connection_name"))
+
+		diags = scimConfigDelete(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Empty(d.Id())
+	})
+}
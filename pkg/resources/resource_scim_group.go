@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SCIMGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "A group of users in the organization. The roles of the group are granted to all of its members, including the members provisioned by SCIM.",
+
+		CreateContext: scimGroupCreate,
+		ReadContext:   scimGroupRead,
+		UpdateContext: scimGroupUpdate,
+		DeleteContext: scimGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the group. It must match the name of the group in the identity provider to map SCIM provisioned members.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the group.",
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The roles to grant to the members of the group. Allowed values are 'Member', 'Admin' and the names of custom organization roles.",
+			},
+		},
+	}
+}
+
+// GroupRequest is used to serialize the request body for creating or updating a group.
+type GroupRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// GroupResponse represents a group in the Frontegg API.
+type GroupResponse struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Roles       []FronteggRole `json:"roles"`
+}
+
+func scimGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := GroupRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	var group GroupResponse
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "POST", groupsPath, body, &group); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating group: %s", err))
+	}
+	d.SetId(group.ID)
+
+	roleNames := convertToStringSlice(d.Get("roles").(*schema.Set).List())
+	if err := updateGroupRoles(ctx, providerMeta.Frontegg, group.ID, nil, roleNames); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return scimGroupRead(ctx, d, meta)
+}
+
+func scimGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var group GroupResponse
+	path := fmt.Sprintf("%s/%s?_groupsRelations=roles", groupsPath, d.Id())
+	err = fronteggRequest(ctx, providerMeta.Frontegg, "GET", path, nil, &group)
+	if errors.Is(err, errFronteggNotFound) {
+		log.Printf("[WARN] Group %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading group: %s", err))
+	}
+
+	configured := convertToStringSlice(d.Get("roles").(*schema.Set).List())
+	if err := setAttributes(d, map[string]interface{}{
+		"name":        group.Name,
+		"description": group.Description,
+		"roles":       getRoleNames(group.Roles, configured),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func scimGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description") {
+		body := GroupRequest{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		path := fmt.Sprintf("%s/%s", groupsPath, d.Id())
+		if err := fronteggRequest(ctx, providerMeta.Frontegg, "PATCH", path, body, nil); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating group: %s", err))
+		}
+	}
+
+	if d.HasChange("roles") {
+		o, n := d.GetChange("roles")
+		oldRoleNames := convertToStringSlice(o.(*schema.Set).List())
+		newRoleNames := convertToStringSlice(n.(*schema.Set).List())
+		if err := updateGroupRoles(ctx, providerMeta.Frontegg, d.Id(), oldRoleNames, newRoleNames); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return scimGroupRead(ctx, d, meta)
+}
+
+func scimGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := fmt.Sprintf("%s/%s", groupsPath, d.Id())
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "DELETE", path, nil, nil); err != nil && !errors.Is(err, errFronteggNotFound) {
+		return diag.FromErr(fmt.Errorf("error deleting group: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateGroupRoles assigns the roles that were added to a group and removes
+// the roles that were removed from it.
+func updateGroupRoles(ctx context.Context, client *clients.FronteggClient, groupID string, oldRoleNames, newRoleNames []string) error {
+	if len(oldRoleNames) == 0 && len(newRoleNames) == 0 {
+		return nil
+	}
+
+	roleMap, err := listRoles(ctx, client)
+	if err != nil {
+		return fmt.Errorf("error fetching roles: %s", err)
+	}
+
+	newRoleIDs, err := getRoleIDs(roleMap, newRoleNames)
+	if err != nil {
+		return err
+	}
+
+	// Roles that were removed from the organization cannot be removed from the group
+	var oldRoleIDs []string
+	for _, roleName := range oldRoleNames {
+		if roleID, ok := roleMap[roleName]; ok {
+			oldRoleIDs = append(oldRoleIDs, roleID)
+		}
+	}
+
+	path := fmt.Sprintf("%s/%s/roles", groupsPath, groupID)
+	if add := diffRoleIDs(newRoleIDs, oldRoleIDs); len(add) > 0 {
+		if err := fronteggRequest(ctx, client, "POST", path, RoleIDsRequest{RoleIDs: add}, nil); err != nil {
+			return fmt.Errorf("error assigning roles to group: %s", err)
+		}
+	}
+
+	if remove := diffRoleIDs(oldRoleIDs, newRoleIDs); len(remove) > 0 {
+		if err := fronteggRequest(ctx, client, "DELETE", path, RoleIDsRequest{RoleIDs: remove}, nil); err != nil {
+			return fmt.Errorf("error removing roles from group: %s", err)
+		}
+	}
+
+	return nil
+}
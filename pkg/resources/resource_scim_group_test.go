@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSCIMGroupResource(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		providerMeta := fronteggProviderMeta(serverURL)
+
+		in := map[string]interface{}{
+			"name":        "data-engineers",
+			"description": "Data engineering team",
+			"roles":       []interface{}{"Member", "Billing Manager"},
+		}
+		d := schema.TestResourceDataRaw(t, SCIMGroup().Schema, in)
+
+		diags := scimGroupCreate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.NotEmpty(d.Id())
+		r.Equal("data-engineers", d.Get("name"))
+		r.ElementsMatch([]interface{}{"Member", "Billing Manager"}, d.Get("roles").(*schema.Set).List())
+
+		// Rename the group and add a role
+		id := d.Id()
+		in["name"] = "analytics-engineers"
+		in["roles"] = []interface{}{"Member", "Billing Manager", "Admin"}
+		d = schema.TestResourceDataRaw(t, SCIMGroup().Schema, in)
+		d.SetId(id)
+		diags = scimGroupUpdate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Equal("analytics-engineers", d.Get("name"))
+		r.ElementsMatch([]interface{}{"Member", "Billing Manager", "Admin"}, d.Get("roles").(*schema.Set).List())
+
+		// Remove a role
+		err := updateGroupRoles(context.TODO(), providerMeta.Frontegg, id, []string{"Member", "Billing Manager", "Admin"}, []string{"Member", "Admin"})
+		r.NoError(err)
+		diags = scimGroupRead(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.ElementsMatch([]interface{}{"Member", "Admin"}, d.Get("roles").(*schema.Set).List())
+
+		diags = scimGroupDelete(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestSCIMGroupResourceUnknownRole(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		providerMeta := fronteggProviderMeta(serverURL)
+
+		in := map[string]interface{}{
+			"name":  "data-engineers",
+			"roles": []interface{}{"Owner"},
+		}
+		d := schema.TestResourceDataRaw(t, SCIMGroup().Schema, in)
+
+		diags := scimGroupCreate(context.TODO(), d, providerMeta)
+		r.True(diags.HasError())
+		r.Equal("role not found: Owner", diags[0].Summary)
+	})
+}
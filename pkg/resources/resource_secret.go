@@ -29,7 +29,7 @@ var secretSchema = map[string]*schema.Schema{
 }
 
 func Secret() *schema.Resource {
-	return &schema.Resource{
+	return withRegions(&schema.Resource{
 		Description: "A secret securely stores sensitive credentials (like passwords and SSL keys) in Materialize’s secret management system.",
 
 		CreateContext: secretCreate,
@@ -42,7 +42,7 @@ func Secret() *schema.Resource {
 		},
 
 		Schema: secretSchema,
-	}
+	})
 }
 
 func secretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
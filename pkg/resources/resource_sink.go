@@ -130,3 +130,33 @@ func sinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 	return nil
 }
+
+// flattenSinkFormatSpec converts a format into the state layout of
+// SinkFormatSpecSchema. Avro documentation comments are kept as configured.
+func flattenSinkFormatSpec(f materialize.SinkFormatSpecStruct, prior interface{}) []interface{} {
+	p := firstBlock(prior)
+	m := map[string]interface{}{}
+
+	switch {
+	case f.Avro != nil:
+		a := firstBlock(p["avro"])
+		avro := map[string]interface{}{
+			"schema_registry_connection": flattenIdentifierSchema(f.Avro.SchemaRegistryConnection),
+			"avro_key_fullname":          preserveState(f.Avro.AvroKeyFullname, a["avro_key_fullname"]),
+			"avro_value_fullname":        preserveState(f.Avro.AvroValueFullname, a["avro_value_fullname"]),
+		}
+		if v, ok := a["avro_doc_type"]; ok {
+			avro["avro_doc_type"] = v
+		}
+		if v, ok := a["avro_doc_column"]; ok {
+			avro["avro_doc_column"] = v
+		}
+		m["avro"] = []interface{}{avro}
+	case f.Json:
+		m["json"] = true
+	default:
+		return []interface{}{}
+	}
+
+	return []interface{}{m}
+}
@@ -91,7 +91,7 @@ func SinkKafka() *schema.Resource {
 		Description: "A Kafka sink establishes a link to a Kafka cluster that you want Materialize to write data to.",
 
 		CreateContext: sinkKafkaCreate,
-		ReadContext:   sinkRead,
+		ReadContext:   sinkKafkaRead,
 		UpdateContext: sinkUpdate,
 		DeleteContext: sinkDelete,
 
@@ -198,5 +198,66 @@ func sinkKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag
 	}
 	d.SetId(utils.TransformIdWithRegion(string(region), i))
 
-	return sinkRead(ctx, d, meta)
+	return sinkKafkaRead(ctx, d, meta)
+}
+
+func sinkKafkaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := sinkRead(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s, err := materialize.ScanSinkKafka(metaDb, utils.ExtractId(d.Id()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("from", flattenIdentifierSchema(s.From)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("kafka_connection", flattenIdentifierSchema(s.KafkaConnection)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("topic", s.Topic); err != nil {
+		return diag.FromErr(err)
+	}
+
+	compression := s.CompressionType
+	if compression == "none" && d.Get("compression_type").(string) == "" {
+		// Materialize reports the default compression
+		compression = ""
+	}
+	if err := d.Set("compression_type", preserveState(compression, d.Get("compression_type"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("key", s.Key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("key_not_enforced", s.KeyNotEnforced); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("format", flattenSinkFormatSpec(s.Format, d.Get("format"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	envelope := []interface{}{}
+	if s.Envelope.Upsert || s.Envelope.Debezium {
+		envelope = append(envelope, map[string]interface{}{
+			"upsert":   s.Envelope.Upsert,
+			"debezium": s.Envelope.Debezium,
+		})
+	}
+	if err := d.Set("envelope", envelope); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
@@ -117,8 +117,17 @@ func TestResourceSinkKafkaCreate(t *testing.T) {
 		pp := `WHERE mz_sinks.id = 'u1'`
 		testhelpers.MockSinkScan(mock, pp)
 
+		// Query Definition
+		testhelpers.MockSinkKafkaScan(mock, pp)
+
 		if err := sinkKafkaCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
+
+		r.Equal([]interface{}{"key_1", "key_2"}, d.Get("key"))
+		r.Equal("gzip", d.Get("compression_type"))
+		r.Equal("avro_value_fullname", d.Get("format.0.avro.0.avro_value_fullname"))
+		r.Equal("top-level comment", d.Get("format.0.avro.0.avro_doc_type.0.doc"))
+		r.Equal(true, d.Get("envelope.0.upsert"))
 	})
 }
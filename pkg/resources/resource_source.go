@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -151,3 +152,117 @@ func sourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 	}
 	return nil
 }
+
+// flattenIdentifierSchema converts an object name into the state layout of
+// IdentifierSchema. Empty names are stored as an empty block list.
+func flattenIdentifierSchema(i materialize.IdentifierSchemaStruct) []interface{} {
+	if i.Name == "" {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"name":          i.Name,
+			"schema_name":   i.SchemaName,
+			"database_name": i.DatabaseName,
+		},
+	}
+}
+
+// preserveState keeps the value in state when the catalog does not report
+// it or only differs in case, as options are case-insensitive.
+func preserveState(value string, prior interface{}) string {
+	p, _ := prior.(string)
+	if p != "" && (value == "" || strings.EqualFold(value, p)) {
+		return p
+	}
+	return value
+}
+
+// firstBlock returns the attributes of the first block of a nested list.
+func firstBlock(v interface{}) map[string]interface{} {
+	if l, ok := v.([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		return l[0].(map[string]interface{})
+	}
+	return map[string]interface{}{}
+}
+
+// flattenSourceFormatSpec converts a format into the state layout of
+// FormatSpecSchema. Schema registry strategies and Protobuf message names are
+// resolved into seed schemas by Materialize and are kept as configured.
+func flattenSourceFormatSpec(f materialize.SourceFormatSpecStruct, prior interface{}) []interface{} {
+	p := firstBlock(prior)
+	m := map[string]interface{}{}
+
+	switch {
+	case f.Avro != nil:
+		a := firstBlock(p["avro"])
+		m["avro"] = []interface{}{
+			map[string]interface{}{
+				"schema_registry_connection": flattenIdentifierSchema(f.Avro.SchemaRegistryConnection),
+				"key_strategy":               preserveState(f.Avro.KeyStrategy, a["key_strategy"]),
+				"value_strategy":             preserveState(f.Avro.ValueStrategy, a["value_strategy"]),
+			},
+		}
+	case f.Protobuf != nil:
+		pb := firstBlock(p["protobuf"])
+		m["protobuf"] = []interface{}{
+			map[string]interface{}{
+				"schema_registry_connection": flattenIdentifierSchema(f.Protobuf.SchemaRegistryConnection),
+				"message":                    preserveState(f.Protobuf.MessageName, pb["message"]),
+			},
+		}
+	case f.Csv != nil:
+		c := firstBlock(p["csv"])
+		delimiter := f.Csv.DelimitedBy
+		if delimiter == "," && c["delimited_by"] == nil {
+			// Materialize reports the default delimiter
+			delimiter = ""
+		}
+		m["csv"] = []interface{}{
+			map[string]interface{}{
+				"column":       f.Csv.Columns,
+				"delimited_by": preserveState(delimiter, c["delimited_by"]),
+				"header":       f.Csv.Header,
+			},
+		}
+	case f.Bytes:
+		m["bytes"] = true
+	case f.Text:
+		m["text"] = true
+	case f.Json:
+		m["json"] = true
+	default:
+		return []interface{}{}
+	}
+
+	return []interface{}{m}
+}
+
+// flattenSourceEnvelope converts an envelope into the state layout of
+// SourceEnvelopeSchema.
+func flattenSourceEnvelope(e materialize.KafkaSourceEnvelopeStruct, prior interface{}) []interface{} {
+	envelope := map[string]interface{}{
+		"upsert":   e.Upsert,
+		"debezium": e.Debezium,
+		// Append-only is the default envelope and may be omitted
+		"none": e.None || (!e.Upsert && !e.Debezium && firstBlock(prior)["none"] == true),
+	}
+	if vde := e.UpsertOptions.ValueDecodingErrors; vde.Inline {
+		envelope["upsert_options"] = []interface{}{
+			map[string]interface{}{
+				"value_decoding_errors": []interface{}{
+					map[string]interface{}{
+						"inline": []interface{}{
+							map[string]interface{}{"enabled": true, "alias": vde.Alias},
+						},
+					},
+				},
+			},
+		}
+	}
+	envelopes := []interface{}{}
+	if envelope["upsert"] == true || envelope["debezium"] == true || envelope["none"] == true {
+		envelopes = append(envelopes, envelope)
+	}
+	return envelopes
+}
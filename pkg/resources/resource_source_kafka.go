@@ -90,38 +90,7 @@ var sourceKafkaSchema = map[string]*schema.Schema{
 	"format":       FormatSpecSchema("format", "How to decode raw bytes from different formats into data structures Materialize can understand at runtime.", false),
 	"key_format":   FormatSpecSchema("key_format", "Set the key format explicitly.", false),
 	"value_format": FormatSpecSchema("value_format", "Set the value format explicitly.", false),
-	"envelope": {
-		Description: "How Materialize should interpret records (e.g. append-only, upsert)..",
-		Type:        schema.TypeList,
-		MaxItems:    1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"upsert": {
-					Description:   "Use the upsert envelope, which uses message keys to handle CRUD operations.",
-					Type:          schema.TypeBool,
-					Optional:      true,
-					ForceNew:      true,
-					ConflictsWith: []string{"envelope.0.debezium", "envelope.0.none"},
-				},
-				"debezium": {
-					Description:   "Use the Debezium envelope, which uses a diff envelope to handle CRUD operations.",
-					Type:          schema.TypeBool,
-					Optional:      true,
-					ForceNew:      true,
-					ConflictsWith: []string{"envelope.0.upsert", "envelope.0.none"},
-				},
-				"none": {
-					Description:   "Use an append-only envelope. This means that records will only be appended and cannot be updated or deleted.",
-					Type:          schema.TypeBool,
-					Optional:      true,
-					ForceNew:      true,
-					ConflictsWith: []string{"envelope.0.upsert", "envelope.0.debezium"},
-				},
-			},
-		},
-		Optional: true,
-		ForceNew: true,
-	},
+	"envelope":     SourceEnvelopeSchema(),
 	"start_offset": {
 		Description:   "Read partitions from the specified offset.",
 		Type:          schema.TypeList,
@@ -137,7 +106,7 @@ var sourceKafkaSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 		ConflictsWith: []string{"start_offset"},
 	},
-	"expose_progress": IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
+	"expose_progress": ExposeProgressSchema(),
 	"subsource":       SubsourceSchema(),
 	"ownership_role":  OwnershipRoleSchema(),
 	"region":          RegionSchema(),
@@ -148,7 +117,7 @@ func SourceKafka() *schema.Resource {
 		Description: "A Kafka source describes a Kafka cluster you want Materialize to read data from.",
 
 		CreateContext: sourceKafkaCreate,
-		ReadContext:   sourceRead,
+		ReadContext:   sourceKafkaRead,
 		UpdateContext: sourceUpdate,
 		DeleteContext: sourceDelete,
 
@@ -297,5 +266,80 @@ func sourceKafkaCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 	d.SetId(utils.TransformIdWithRegion(string(region), i))
 
-	return sourceRead(ctx, d, meta)
+	return sourceKafkaRead(ctx, d, meta)
+}
+
+func sourceKafkaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := sourceRead(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s, err := materialize.ScanSourceKafka(metaDb, utils.ExtractId(d.Id()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("kafka_connection", flattenIdentifierSchema(s.KafkaConnection)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("topic", s.Topic); err != nil {
+		return diag.FromErr(err)
+	}
+
+	include := map[string]struct {
+		include bool
+		alias   string
+	}{
+		"key":       {s.IncludeKey, s.KeyAlias},
+		"headers":   {s.IncludeHeaders, s.HeadersAlias},
+		"partition": {s.IncludePartition, s.PartitionAlias},
+		"offset":    {s.IncludeOffset, s.OffsetAlias},
+		"timestamp": {s.IncludeTimestamp, s.TimestampAlias},
+	}
+	for k, v := range include {
+		if err := d.Set("include_"+k, v.include); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("include_"+k+"_alias", v.alias); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("format", flattenSourceFormatSpec(s.Format, d.Get("format"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("key_format", flattenSourceFormatSpec(s.KeyFormat, d.Get("key_format"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("value_format", flattenSourceFormatSpec(s.ValueFormat, d.Get("value_format"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("envelope", flattenSourceEnvelope(s.Envelope, d.Get("envelope"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Materialize resolves start timestamps into per partition offsets
+	if s.StartTimestamp != 0 || d.Get("start_timestamp").(int) == 0 {
+		if err := d.Set("start_offset", s.StartOffset); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set("start_timestamp", s.StartTimestamp); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("expose_progress", flattenIdentifierSchema(s.ExposeProgress)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
@@ -84,6 +84,80 @@ func TestResourceSourceKafkaCreate(t *testing.T) {
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
 
+		// Query Definition
+		testhelpers.MockSourceKafkaScan(mock, pp)
+
+		if err := sourceKafkaCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("topic", d.Get("topic"))
+		r.Equal("key", d.Get("include_key_alias"))
+		r.Equal("csr_conn", d.Get("format.0.avro.0.schema_registry_connection.0.name"))
+		r.Equal("avro_key_fullname", d.Get("format.0.avro.0.value_strategy"))
+		r.Equal(true, d.Get("envelope.0.upsert"))
+		r.Equal(-1000, d.Get("start_timestamp"))
+		r.Equal("source_progress", d.Get("expose_progress.0.name"))
+	})
+}
+
+func TestResourceSourceKafkaUpsertOptionsCreate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":             "source",
+		"schema_name":      "schema",
+		"database_name":    "database",
+		"cluster_name":     "cluster",
+		"kafka_connection": []interface{}{map[string]interface{}{"name": "kafka_conn"}},
+		"topic":            "topic",
+		"format":           []interface{}{map[string]interface{}{"json": true}},
+		"envelope": []interface{}{
+			map[string]interface{}{
+				"upsert": true,
+				"upsert_options": []interface{}{
+					map[string]interface{}{
+						"value_decoding_errors": []interface{}{
+							map[string]interface{}{
+								"inline": []interface{}{
+									map[string]interface{}{
+										"enabled": true,
+										"alias":   "decoding_error",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, SourceKafka().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			IN CLUSTER "cluster" FROM KAFKA CONNECTION "materialize"."public"."kafka_conn" \(TOPIC 'topic'\)
+			FORMAT JSON
+			ENVELOPE UPSERT \(VALUE DECODING ERRORS = \(INLINE AS "decoding_error"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		// Query Definition
+		testhelpers.MockSourceKafkaScan(mock, pp)
+
 		if err := sourceKafkaCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
 )
 
 var tick_interval = &schema.Schema{
@@ -62,7 +64,7 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
-		ConflictsWith: []string{"auction_options", "marketing_options", "tpch_options"},
+		ConflictsWith: []string{"auction_options", "marketing_options", "tpch_options", "key_value_options", "clock_options"},
 	},
 	"auction_options": {
 		Description: "Auction Options.",
@@ -77,7 +79,7 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
-		ConflictsWith: []string{"counter_options", "marketing_options", "tpch_options"},
+		ConflictsWith: []string{"counter_options", "marketing_options", "tpch_options", "key_value_options", "clock_options"},
 	},
 	"marketing_options": {
 		Description: "Marketing Options.",
@@ -92,7 +94,7 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
-		ConflictsWith: []string{"counter_options", "auction_options", "tpch_options"},
+		ConflictsWith: []string{"counter_options", "auction_options", "tpch_options", "key_value_options", "clock_options"},
 	},
 	"tpch_options": {
 		Description: "TPCH Options.",
@@ -107,7 +109,92 @@ var sourceLoadgenSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
-		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options"},
+		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options", "key_value_options", "clock_options"},
+	},
+	"key_value_options": {
+		Description: "Key Value Options.",
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Description: "The number of keys in the source. Must be divisible by the product of `partitions` and `batch_size`.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"snapshot_rounds": {
+					Description: "The number of rounds of data, each updating every key, produced in the snapshot.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"value_size": {
+					Description: "The number of bytes of each value.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"seed": {
+					Description: "The seed of the random number generator, so the data is the same each time the source is created.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"partitions": {
+					Description: "The number of partitions to spread the keys across.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"batch_size": {
+					Description: "The number of keys produced per partition in each tick.",
+					Type:        schema.TypeInt,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"tick_interval": tick_interval,
+			},
+		},
+		Optional:      true,
+		MinItems:      1,
+		MaxItems:      1,
+		ForceNew:      true,
+		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options", "tpch_options", "clock_options"},
+	},
+	"clock_options": {
+		Description: "Clock Options.",
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tick_interval": tick_interval,
+			},
+		},
+		Optional:      true,
+		MinItems:      1,
+		MaxItems:      1,
+		ForceNew:      true,
+		ConflictsWith: []string{"counter_options", "auction_options", "marketing_options", "tpch_options", "key_value_options"},
+	},
+	"table": {
+		Description: "Creates subsources for specific tables of the `AUCTION`, `MARKETING` and `TPCH` load generators. If not specified, will default to ALL TABLES. Tables whose subsource was dropped are removed on refresh, which recreates the source.",
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "The name of the table.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"alias": {
+					Description: "The alias of the table.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+			},
+		},
+		Optional: true,
+		MinItems: 1,
+		ForceNew: true,
 	},
 	"expose_progress": IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
 	"subsource":       SubsourceSchema(),
@@ -120,12 +207,29 @@ func SourceLoadgen() *schema.Resource {
 		Description: "A load generator source produces synthetic data for use in demos and performance tests.",
 
 		CreateContext: sourceLoadgenCreate,
-		ReadContext:   sourceRead,
+		ReadContext:   sourceLoadgenRead,
 		UpdateContext: sourceUpdate,
 		DeleteContext: sourceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(sourceLoadgenRead, materialize.BaseSource, sourceLoadgenImport),
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			t := strings.ToUpper(d.Get("load_generator_type").(string))
+			if _, ok := d.GetOk("table"); ok && !slices.Contains(loadGeneratorTableTypes, t) {
+				return fmt.Errorf("table is only supported by the %s load generators", strings.Join(loadGeneratorTableTypes, ", "))
+			}
+			for k, lt := range loadGeneratorOptions {
+				if _, ok := d.GetOk(k); ok && lt != t {
+					return fmt.Errorf("%s is only supported by the %s load generator", k, lt)
+				}
+			}
+			// The KEY VALUE load generator has no default for its options
+			if _, ok := d.GetOk("key_value_options"); !ok && t == "KEY VALUE" {
+				return fmt.Errorf("key_value_options is required by the KEY VALUE load generator")
+			}
+			return nil
 		},
 
 		Schema: sourceLoadgenSchema,
@@ -181,6 +285,21 @@ func sourceLoadgenCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		b.TPCHOptions(o)
 	}
 
+	if v, ok := d.GetOk("key_value_options"); ok {
+		o := materialize.GetKeyValueOptionsStruct(v)
+		b.KeyValueOptions(o)
+	}
+
+	if v, ok := d.GetOk("clock_options"); ok {
+		o := materialize.GetClockOptionsStruct(v)
+		b.ClockOptions(o)
+	}
+
+	if v, ok := d.GetOk("table"); ok {
+		t := materialize.GetTableStruct(v.([]interface{}))
+		b.Table(t)
+	}
+
 	// create resource
 	if err := b.Create(); err != nil {
 		return diag.FromErr(err)
@@ -215,5 +334,95 @@ func sourceLoadgenCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.SetId(utils.TransformIdWithRegion(string(region), i))
 
-	return sourceRead(ctx, d, meta)
+	return sourceLoadgenRead(ctx, d, meta)
+}
+
+func sourceLoadgenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := sourceRead(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	// Keep the configured tables that still have a subsource
+	if v, ok := d.GetOk("table"); ok {
+		subsources := map[string]bool{}
+		for _, s := range d.Get("subsource").([]interface{}) {
+			subsources[s.(map[string]interface{})["name"].(string)] = true
+		}
+
+		tables := []interface{}{}
+		for _, t := range materialize.GetTableStruct(v.([]interface{})) {
+			if subsources[subsourceName(t)] {
+				tables = append(tables, map[string]interface{}{"name": t.Name, "alias": t.Alias})
+			}
+		}
+		if err := d.Set("table", tables); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func sourceLoadgenImport(d *schema.ResourceData, createSql string) error {
+	s, err := materialize.ParseSourceLoadgen(createSql)
+	if err != nil {
+		return err
+	}
+
+	tables := []interface{}{}
+	for _, t := range s.Tables {
+		tables = append(tables, map[string]interface{}{"name": t.Name, "alias": t.Alias})
+	}
+
+	attributes := map[string]interface{}{
+		"load_generator_type": s.LoadGeneratorType,
+		"expose_progress":     flattenIdentifierSchema(s.ExposeProgress),
+		"table":               tables,
+	}
+	// Options are only set if present in the statement, as they default to
+	// an empty block
+	switch s.LoadGeneratorType {
+	case "COUNTER":
+		if o := s.CounterOptions; o != (materialize.CounterOptions{}) {
+			m := loadgenOptions(o.TickInterval, o.ScaleFactor)
+			m["max_cardinality"] = o.MaxCardinality
+			attributes["counter_options"] = []interface{}{m}
+		}
+	case "AUCTION":
+		if o := s.AuctionOptions; o != (materialize.AuctionOptions{}) {
+			attributes["auction_options"] = []interface{}{loadgenOptions(o.TickInterval, o.ScaleFactor)}
+		}
+	case "MARKETING":
+		if o := s.MarketingOptions; o != (materialize.MarketingOptions{}) {
+			attributes["marketing_options"] = []interface{}{loadgenOptions(o.TickInterval, o.ScaleFactor)}
+		}
+	case "TPCH":
+		if o := s.TPCHOptions; o != (materialize.TPCHOptions{}) {
+			attributes["tpch_options"] = []interface{}{loadgenOptions(o.TickInterval, o.ScaleFactor)}
+		}
+	case "KEY VALUE":
+		o := s.KeyValueOptions
+		attributes["key_value_options"] = []interface{}{map[string]interface{}{
+			"keys":            o.Keys,
+			"snapshot_rounds": o.SnapshotRounds,
+			"value_size":      o.ValueSize,
+			"seed":            o.Seed,
+			"partitions":      o.Partitions,
+			"batch_size":      o.BatchSize,
+			"tick_interval":   o.TickInterval,
+		}}
+	case "CLOCK":
+		if o := s.ClockOptions; o != (materialize.ClockOptions{}) {
+			attributes["clock_options"] = []interface{}{map[string]interface{}{"tick_interval": o.TickInterval}}
+		}
+	}
+
+	return setAttributes(d, attributes)
+}
+
+func loadgenOptions(tickInterval string, scaleFactor float64) map[string]interface{} {
+	if scaleFactor == 0 {
+		scaleFactor = scale_factor.Default.(float64)
+	}
+	return map[string]interface{}{"tick_interval": tickInterval, "scale_factor": scaleFactor}
 }
@@ -9,6 +9,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,3 +61,203 @@ func TestResourceSourceLoadgenCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceSourceLoadgenKeyValueCreate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                "source",
+		"schema_name":         "schema",
+		"database_name":       "database",
+		"cluster_name":        "cluster",
+		"load_generator_type": "KEY VALUE",
+		"key_value_options": []interface{}{map[string]interface{}{
+			"keys":            16,
+			"snapshot_rounds": 3,
+			"value_size":      10,
+			"seed":            123,
+			"partitions":      4,
+			"batch_size":      2,
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, SourceLoadgen().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			IN CLUSTER "cluster"
+			FROM LOAD GENERATOR KEY VALUE
+			\(KEYS 16, SNAPSHOT ROUNDS 3, VALUE SIZE 10, SEED 123, PARTITIONS 4, BATCH SIZE 2\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		if err := sourceLoadgenCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourceLoadgenTableCreate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                "source",
+		"schema_name":         "schema",
+		"database_name":       "database",
+		"cluster_name":        "cluster",
+		"load_generator_type": "AUCTION",
+		"table": []interface{}{
+			map[string]interface{}{"name": "bids", "alias": "auction_bids"},
+			map[string]interface{}{"name": "users"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, SourceLoadgen().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source"
+			IN CLUSTER "cluster"
+			FROM LOAD GENERATOR AUCTION
+			FOR TABLES \(bids AS auction_bids, users AS users\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceNamesScan(mock, ps, "auction_bids", "users")
+
+		if err := sourceLoadgenCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Len(d.Get("table"), 2)
+	})
+}
+
+func TestResourceSourceLoadgenReadDroppedTable(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                "source",
+		"schema_name":         "schema",
+		"database_name":       "database",
+		"load_generator_type": "AUCTION",
+		"table": []interface{}{
+			map[string]interface{}{"name": "bids", "alias": "auction_bids"},
+			map[string]interface{}{"name": "users"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, SourceLoadgen().Schema, in)
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// The auction_bids subsource was dropped
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceNamesScan(mock, ps, "users")
+
+		if err := sourceLoadgenRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal([]interface{}{map[string]interface{}{"name": "users", "alias": ""}}, d.Get("table"))
+	})
+}
+
+func TestResourceSourceLoadgenImportTables(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceLoadgen().Schema, map[string]interface{}{})
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceNamesScan(mock, ps, "auction_bids", "users")
+
+		// Query Definition
+		testhelpers.MockShowCreate(mock, `SOURCE "database"."schema"."source"`,
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM LOAD GENERATOR AUCTION FOR TABLES ("bids" AS "database"."schema"."auction_bids", "users" AS "database"."schema"."users")`)
+
+		s, err := SourceLoadgen().Importer.StateContext(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(s, 1)
+		r.Equal("AUCTION", d.Get("load_generator_type"))
+		r.Equal([]interface{}{
+			map[string]interface{}{"name": "bids", "alias": "auction_bids"},
+			map[string]interface{}{"name": "users", "alias": ""},
+		}, d.Get("table"))
+	})
+}
+
+func TestResourceSourceLoadgenTableValidation(t *testing.T) {
+	r := require.New(t)
+
+	_, err := SourceLoadgen().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "source",
+		"cluster_name":        "cluster",
+		"load_generator_type": "COUNTER",
+		"table": []interface{}{
+			map[string]interface{}{"name": "bids"},
+		},
+	}), nil)
+	r.ErrorContains(err, "table is only supported by the AUCTION, MARKETING, TPCH load generators")
+}
+
+func TestResourceSourceLoadgenOptionsValidation(t *testing.T) {
+	r := require.New(t)
+
+	_, err := SourceLoadgen().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "source",
+		"cluster_name":        "cluster",
+		"load_generator_type": "KEY VALUE",
+	}), nil)
+	r.ErrorContains(err, "key_value_options is required by the KEY VALUE load generator")
+
+	_, err = SourceLoadgen().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "source",
+		"cluster_name":        "cluster",
+		"load_generator_type": "COUNTER",
+		"auction_options": []interface{}{
+			map[string]interface{}{"tick_interval": "1s"},
+		},
+	}), nil)
+	r.ErrorContains(err, "auction_options is only supported by the AUCTION load generator")
+
+	_, err = SourceLoadgen().Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "source",
+		"cluster_name":        "cluster",
+		"load_generator_type": "counter",
+		"counter_options": []interface{}{
+			map[string]interface{}{"tick_interval": "1s"},
+		},
+	}), nil)
+	r.NoError(err)
+}
@@ -2,13 +2,17 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/exp/slices"
 )
 
 var sourcePostgresSchema = map[string]*schema.Schema{
@@ -33,7 +37,7 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 		Optional:    true,
 	},
 	"table": {
-		Description: "Creates subsources for specific tables. If neither table or schema is specified, will default to ALL TABLES",
+		Description: "Creates subsources for specific tables. If neither table or schema is specified, will default to ALL TABLES unless `all_tables` is `false`. The tables are read back from the subsources of the source only when `table` is configured.",
 		Type:        schema.TypeList,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
@@ -54,7 +58,7 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 		ConflictsWith: []string{"schema"},
 	},
 	"schema": {
-		Description:   "Creates subsources for specific schemas. If neither table or schema is specified, will default to ALL TABLES",
+		Description:   "Creates subsources for specific schemas. If neither table or schema is specified, will default to ALL TABLES unless `all_tables` is `false`. The schemas are not read back from Materialize, so changing them outside of Terraform is not detected.",
 		Type:          schema.TypeList,
 		Elem:          &schema.Schema{Type: schema.TypeString},
 		Optional:      true,
@@ -62,7 +66,24 @@ var sourcePostgresSchema = map[string]*schema.Schema{
 		MinItems:      1,
 		ConflictsWith: []string{"table"},
 	},
-	"expose_progress": IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false),
+	"all_tables": {
+		Description: "Create a subsource for every table of the publication when neither `table` nor `schema` is specified. Set to `false` to create the source without subsources when its tables are managed with `materialize_source_table`. Only used when the source is created, so changing it does not alter an existing source.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+	},
+	"refresh_on_schema_change": {
+		Description: "Recreate the subsource of a `table` when Materialize reports that its upstream table was altered in an incompatible way. The subsource is dropped and added again on the next apply, which takes a new snapshot of the upstream table.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"replication_slot": {
+		Description: "The name of the replication slot Materialize created in the upstream PostgreSQL database.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"expose_progress": ExposeProgressSchema(),
 	"subsource":       SubsourceSchema(),
 	"ownership_role":  OwnershipRoleSchema(),
 	"region":          RegionSchema(),
@@ -73,7 +94,7 @@ func SourcePostgres() *schema.Resource {
 		Description: "A Postgres source describes a PostgreSQL instance you want Materialize to read data from.",
 
 		CreateContext: sourcePostgresCreate,
-		ReadContext:   sourceRead,
+		ReadContext:   sourcePostgresRead,
 		UpdateContext: sourcePostgresUpdate,
 		DeleteContext: sourceDelete,
 
@@ -85,6 +106,54 @@ func SourcePostgres() *schema.Resource {
 	}
 }
 
+func sourcePostgresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := sourceRead(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s, err := materialize.ScanSourcePostgres(metaDb, utils.ExtractId(d.Id()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("postgres_connection", flattenIdentifierSchema(s.PostgresConnection)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("publication", s.Publication); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("replication_slot", s.ReplicationSlot); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("expose_progress", flattenIdentifierSchema(s.ExposeProgress)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Subsources of the configured tables
+	var diags diag.Diagnostics
+	if v, ok := d.GetOk("table"); ok {
+		subsources, err := materialize.ListSourcePostgresTables(metaDb, utils.ExtractId(d.Id()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var tables []interface{}
+		tables, diags = flattenSourcePostgresTables(v.([]interface{}), subsources, d.Get("refresh_on_schema_change").(bool))
+		if err := d.Set("table", tables); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
 func sourcePostgresCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	sourceName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -124,6 +193,8 @@ func sourcePostgresCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		b.Schema(schemas)
 	}
 
+	b.AllTables(d.Get("all_tables").(bool))
+
 	if v, ok := d.GetOk("expose_progress"); ok {
 		e := materialize.GetIdentifierSchemaStruct(v)
 		b.ExposeProgress(e)
@@ -168,7 +239,7 @@ func sourcePostgresCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.SetId(utils.TransformIdWithRegion(string(region), i))
 
-	return sourceRead(ctx, d, meta)
+	return sourcePostgresRead(ctx, d, meta)
 }
 
 func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -220,6 +291,21 @@ func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 				colDiff = diffTextColumns(nc.([]interface{}), oc.([]interface{}))
 			}
 
+			// Subsources stalled by an upstream schema change are removed
+			// from state on read and recreated here
+			if d.Get("refresh_on_schema_change").(bool) {
+				stale, err := staleSourcePostgresTables(metaDb, utils.ExtractId(d.Id()), addTables)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				if len(stale) > 0 {
+					if err := b.DropSubsource(stale); err != nil {
+						return diag.FromErr(err)
+					}
+					colDiff = appendTableTextColumns(colDiff, stale, d.Get("text_columns").([]interface{}))
+				}
+			}
+
 			if err := b.AddSubsource(addTables, colDiff); err != nil {
 				return diag.FromErr(err)
 			}
@@ -240,7 +326,7 @@ func sourcePostgresUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
-	return sourceRead(ctx, d, meta)
+	return sourcePostgresRead(ctx, d, meta)
 }
 
 func diffTextColumns(arr1, arr2 []interface{}) []string {
@@ -259,3 +345,102 @@ func diffTextColumns(arr1, arr2 []interface{}) []string {
 	}
 	return difference
 }
+
+// subsourceName returns the name of the subsource created for a table.
+func subsourceName(t materialize.TableStruct) string {
+	n := t.Alias
+	if n == "" {
+		n = t.Name
+	}
+	if i := strings.LastIndex(n, "."); i >= 0 {
+		n = n[i+1:]
+	}
+	return n
+}
+
+// flattenSourcePostgresTables reconciles the tables in state with the
+// subsources of the source. Tables keep their configured name and alias,
+// dropped subsources are removed and subsources added outside of Terraform
+// are appended. Subsources stalled by an upstream schema change are reported,
+// and removed when refresh is set so they are recreated on the next apply.
+func flattenSourcePostgresTables(prior []interface{}, subsources []materialize.SourcePostgresTableParams, refresh bool) ([]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current := map[string]materialize.SourcePostgresTableParams{}
+	for _, s := range subsources {
+		current[s.SubsourceName.String] = s
+	}
+
+	tables := []interface{}{}
+	known := map[string]bool{}
+	for _, t := range materialize.GetTableStruct(prior) {
+		n := subsourceName(t)
+		s, ok := current[n]
+		if !ok {
+			continue
+		}
+		known[n] = true
+
+		if s.SchemaChanged() {
+			if refresh {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Subsource %s will be recreated", n),
+					Detail:   fmt.Sprintf("The upstream table of subsource %s changed: %s", n, s.Error.String),
+				})
+				continue
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Subsource %s stalled after an upstream schema change", n),
+				Detail:   fmt.Sprintf("%s. Set refresh_on_schema_change to recreate the subsource.", s.Error.String),
+			})
+		}
+
+		tables = append(tables, map[string]interface{}{"name": t.Name, "alias": t.Alias})
+	}
+
+	for _, s := range subsources {
+		if known[s.SubsourceName.String] {
+			continue
+		}
+		tables = append(tables, map[string]interface{}{
+			"name":  s.UpstreamTableName.String,
+			"alias": s.SubsourceName.String,
+		})
+	}
+
+	return tables, diags
+}
+
+// staleSourcePostgresTables returns the tables that still have a subsource
+// stalled by an upstream schema change.
+func staleSourcePostgresTables(conn *sqlx.DB, sourceId string, tables []materialize.TableStruct) ([]materialize.TableStruct, error) {
+	subsources, err := materialize.ListSourcePostgresTables(conn, sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var stale []materialize.TableStruct
+	for _, t := range tables {
+		for _, s := range subsources {
+			if s.SubsourceName.String == subsourceName(t) && s.SchemaChanged() {
+				stale = append(stale, t)
+			}
+		}
+	}
+	return stale, nil
+}
+
+// appendTableTextColumns adds the text columns of the tables, qualified with
+// the table name, that are not in columns yet.
+func appendTableTextColumns(columns []string, tables []materialize.TableStruct, textColumns []interface{}) []string {
+	for _, c := range materialize.GetSliceValueString(textColumns) {
+		for _, t := range tables {
+			if strings.HasPrefix(c, t.Name+".") && !slices.Contains(columns, c) {
+				columns = append(columns, c)
+			}
+		}
+	}
+	return columns
+}
@@ -56,9 +56,19 @@ func TestResourceSourcePostgresCreateTable(t *testing.T) {
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
 
+		// Query Definition
+		testhelpers.MockSourcePostgresScan(mock, pp)
+
+		// Query Tables
+		pt := `WHERE mz_object_dependencies.object_id = 'u1'`
+		testhelpers.MockSourcePostgresTableScan(mock, pt)
+
 		if err := sourcePostgresCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
+
+		r.Equal("mz_source", d.Get("publication"))
+		r.Equal("materialize_u1", d.Get("replication_slot"))
 	})
 }
 
@@ -101,6 +111,54 @@ func TestResourceSourcePostgresCreateSchema(t *testing.T) {
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
 
+		// Query Definition
+		testhelpers.MockSourcePostgresScan(mock, pp)
+
+		if err := sourcePostgresCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourcePostgresCreateNoSubsources(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "source",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"cluster_name":  "cluster",
+		"postgres_connection": []interface{}{
+			map[string]interface{}{
+				"name": "pg_connection",
+			},
+		},
+		"publication": "mz_source",
+		"all_tables":  false,
+	}
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM POSTGRES CONNECTION "materialize"."public"."pg_connection" \(PUBLICATION 'mz_source'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		// Query Definition
+		testhelpers.MockSourcePostgresScan(mock, pp)
+
 		if err := sourcePostgresCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
@@ -129,12 +187,155 @@ func TestResourceSourcePostgresUpdate(t *testing.T) {
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
 
+		// Query Definition
+		testhelpers.MockSourcePostgresScan(mock, pp)
+
+		// Query Tables
+		pt := `WHERE mz_object_dependencies.object_id = 'u1'`
+		testhelpers.MockSourcePostgresTableScan(mock, pt)
+
 		if err := sourcePostgresUpdate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
 	})
 }
 
+func TestResourceSourcePostgresReadTables(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "source",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"table": []interface{}{
+			map[string]interface{}{"name": "name1", "alias": "alias"},
+			map[string]interface{}{"name": "dropped"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, in)
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		// Query Definition
+		testhelpers.MockSourcePostgresScan(mock, pp)
+
+		// Query Tables
+		pt := `WHERE mz_object_dependencies.object_id = 'u1'`
+		testhelpers.MockSourcePostgresTableScan(mock, pt)
+
+		if diags := sourcePostgresRead(context.TODO(), d, db); diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		// The dropped subsource is removed and the subsource added outside
+		// of Terraform is appended
+		r.Equal([]interface{}{
+			map[string]interface{}{"name": "name1", "alias": "alias"},
+			map[string]interface{}{"name": "name2", "alias": "name2"},
+		}, d.Get("table"))
+	})
+}
+
+func TestResourceSourcePostgresReadTablesSchemaChange(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                     "source",
+		"schema_name":              "schema",
+		"database_name":            "database",
+		"refresh_on_schema_change": true,
+		"table": []interface{}{
+			map[string]interface{}{"name": "name1", "alias": "alias"},
+			map[string]interface{}{"name": "name2"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, in)
+	d.SetId("u1")
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		// Query Definition
+		testhelpers.MockSourcePostgresScan(mock, pp)
+
+		// Query Tables
+		pt := `WHERE mz_object_dependencies.object_id = 'u1'`
+		testhelpers.MockSourcePostgresTableSchemaChangeScan(mock, pt)
+
+		diags := sourcePostgresRead(context.TODO(), d, db)
+		r.False(diags.HasError())
+		r.Len(diags, 1)
+
+		// The stalled subsource is removed so it is added again on apply
+		r.Equal([]interface{}{
+			map[string]interface{}{"name": "name1", "alias": "alias"},
+		}, d.Get("table"))
+	})
+}
+
+func TestResourceSourcePostgresUpdateSchemaChange(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":                     "source",
+		"schema_name":              "schema",
+		"database_name":            "database",
+		"refresh_on_schema_change": true,
+		"text_columns":             []interface{}{"name2.unsupported_type_1"},
+		"table": []interface{}{
+			map[string]interface{}{"name": "name1", "alias": "alias"},
+			map[string]interface{}{"name": "name2"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, SourcePostgres().Schema, in)
+	r.NotNil(d)
+
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."" RENAME TO "source";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Tables
+		pt := `WHERE mz_object_dependencies.object_id = 'u1'`
+		testhelpers.MockSourcePostgresTableSchemaChangeScan(mock, pt)
+
+		// Only the stalled subsource is dropped before being added again
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."source" DROP SUBSOURCE "name2";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER SOURCE "database"."schema"."source" ADD SUBSOURCE "name1" AS "alias", "name2" WITH \(TEXT COLUMNS \[name2.unsupported_type_1\]\);`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		// Query Definition
+		testhelpers.MockSourcePostgresScan(mock, pp)
+
+		// Query Tables
+		testhelpers.MockSourcePostgresTableScan(mock, pt)
+
+		if diags := sourcePostgresUpdate(context.TODO(), d, db); diags.HasError() {
+			t.Fatal(diags)
+		}
+	})
+}
+
 func TestDiffTextColumns(t *testing.T) {
 	arr1 := []interface{}{"t1.column_1", "t2.column_2"}
 	arr2 := []interface{}{"t1.column_1", "t3.column_2"}
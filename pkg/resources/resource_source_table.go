@@ -0,0 +1,311 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sourceTableSchema = map[string]*schema.Schema{
+	"name":               ObjectNameSchema("table", true, false),
+	"schema_name":        SchemaNameSchema("table", false),
+	"database_name":      DatabaseNameSchema("table", false),
+	"qualified_sql_name": QualifiedNameSchema("table"),
+	"comment":            CommentSchema(false),
+	"source":             IdentifierSchema("source", "The Postgres, MySQL or Kafka source the table ingests data from.", true),
+	"upstream_name": {
+		Description: "The name of the table in the upstream database of Postgres and MySQL sources, or the topic of Kafka sources. Can be omitted for sources with a single upstream reference.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	},
+	"upstream_schema_name": {
+		Description: "The schema of the table in the upstream database of Postgres and MySQL sources.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	},
+	"text_columns": {
+		Description: "Columns of the upstream table to decode as `text`, for data types that are not supported in Materialize. Only for Postgres and MySQL sources.",
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"exclude_columns": {
+		Description: "Columns of the upstream table to ignore. Only for Postgres and MySQL sources.",
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+	},
+	"format":         FormatSpecSchema("format", "How to decode raw bytes from different formats into data structures Materialize can understand at runtime. Only for Kafka sources.", false),
+	"key_format":     FormatSpecSchema("key_format", "Set the key format explicitly. Only for Kafka sources.", false),
+	"value_format":   FormatSpecSchema("value_format", "Set the value format explicitly. Only for Kafka sources.", false),
+	"envelope":       SourceEnvelopeSchema(),
+	"ownership_role": OwnershipRoleSchema(),
+	"region":         RegionSchema(),
+}
+
+func SourceTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "A table ingesting an upstream table of a Postgres or MySQL source, or a topic of a Kafka source. Source tables replace the subsources of a source.",
+
+		CreateContext: sourceTableCreate,
+		ReadContext:   sourceTableRead,
+		UpdateContext: sourceTableUpdate,
+		DeleteContext: sourceTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: sourceTableSchema,
+	}
+}
+
+func sourceTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s, err := materialize.ScanSourceTable(metaDb, utils.ExtractId(i))
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
+	if err := d.Set("name", s.TableName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("schema_name", s.SchemaName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("database_name", s.DatabaseName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ownership_role", s.OwnerName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	qn := materialize.QualifiedName(s.DatabaseName.String, s.SchemaName.String, s.TableName.String)
+	if err := d.Set("qualified_sql_name", qn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	source := materialize.IdentifierSchemaStruct{
+		Name:         s.SourceName.String,
+		SchemaName:   s.SourceSchemaName.String,
+		DatabaseName: s.SourceDatabaseName.String,
+	}
+	if err := d.Set("source", flattenIdentifierSchema(source)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	t, err := materialize.ScanSourceTableDefinition(metaDb, qn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("upstream_name", t.UpstreamName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("upstream_schema_name", t.UpstreamSchemaName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("text_columns", t.TextColumns); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("exclude_columns", t.ExcludeColumns); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("format", flattenSourceFormatSpec(t.Format, d.Get("format"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("key_format", flattenSourceFormatSpec(t.KeyFormat, d.Get("key_format"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("value_format", flattenSourceFormatSpec(t.ValueFormat, d.Get("value_format"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("envelope", flattenSourceEnvelope(t.Envelope, d.Get("envelope"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func sourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	o := materialize.MaterializeObject{ObjectType: "TABLE", Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSourceTableBuilder(metaDb, o)
+
+	if v, ok := d.GetOk("source"); ok {
+		b.Source(materialize.GetIdentifierSchemaStruct(v))
+	}
+
+	if v, ok := d.GetOk("upstream_name"); ok {
+		b.UpstreamName(v.(string))
+	}
+
+	if v, ok := d.GetOk("upstream_schema_name"); ok {
+		b.UpstreamSchemaName(v.(string))
+	}
+
+	if v, ok := d.GetOk("text_columns"); ok {
+		b.TextColumns(materialize.GetSliceValueString(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("exclude_columns"); ok {
+		b.ExcludeColumns(materialize.GetSliceValueString(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("format"); ok {
+		b.Format(materialize.GetFormatSpecStruc(v))
+	}
+
+	if v, ok := d.GetOk("key_format"); ok {
+		b.KeyFormat(materialize.GetFormatSpecStruc(v))
+	}
+
+	if v, ok := d.GetOk("value_format"); ok {
+		b.ValueFormat(materialize.GetFormatSpecStruc(v))
+	}
+
+	if v, ok := d.GetOk("envelope"); ok {
+		b.Envelope(materialize.GetSourceKafkaEnelopeStruct(v))
+	}
+
+	// create resource
+	if err := b.Create(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// ownership
+	if v, ok := d.GetOk("ownership_role"); ok {
+		ownership := materialize.NewOwnershipBuilder(metaDb, o)
+
+		if err := ownership.Alter(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed ownership, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// object comment
+	if v, ok := d.GetOk("comment"); ok {
+		comment := materialize.NewCommentBuilder(metaDb, o)
+
+		if err := comment.Object(v.(string)); err != nil {
+			log.Printf("[DEBUG] resource failed comment, dropping object: %s", o.Name)
+			b.Drop()
+			return diag.FromErr(err)
+		}
+	}
+
+	// set id
+	i, err := materialize.SourceTableId(metaDb, o)
+	if err != nil {
+		log.Printf("[DEBUG] cannot query table: %s", o.QualifiedName())
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
+	return sourceTableRead(ctx, d, meta)
+}
+
+func sourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	o := materialize.MaterializeObject{ObjectType: "TABLE", Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+
+	if d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		o := materialize.MaterializeObject{ObjectType: "TABLE", Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
+		b := materialize.NewSourceTableBuilder(metaDb, o)
+
+		if err := b.Rename(newName.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("ownership_role") {
+		_, newRole := d.GetChange("ownership_role")
+		b := materialize.NewOwnershipBuilder(metaDb, o)
+
+		if err := b.Alter(newRole.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("comment") {
+		_, newComment := d.GetChange("comment")
+		b := materialize.NewCommentBuilder(metaDb, o)
+
+		if err := b.Object(newComment.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return sourceTableRead(ctx, d, meta)
+}
+
+func sourceTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableName := d.Get("name").(string)
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	o := materialize.MaterializeObject{Name: tableName, SchemaName: schemaName, DatabaseName: databaseName}
+	b := materialize.NewSourceTableBuilder(metaDb, o)
+
+	if err := b.Drop(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var inSourceTable = map[string]interface{}{
+	"name":                 "table",
+	"schema_name":          "schema",
+	"database_name":        "database",
+	"source":               []interface{}{map[string]interface{}{"name": "source"}},
+	"upstream_name":        "upstream_table",
+	"upstream_schema_name": "public",
+	"text_columns":         []interface{}{"a"},
+	"exclude_columns":      []interface{}{"b"},
+	"ownership_role":       "joe",
+	"comment":              "object comment",
+}
+
+func TestResourceSourceTableCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceTable().Schema, inSourceTable)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "materialize"."public"."source"
+			\(REFERENCE "public"."upstream_table"\)
+			WITH \(TEXT COLUMNS \("a"\), EXCLUDE COLUMNS \("b"\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Ownership
+		mock.ExpectExec(`ALTER TABLE "database"."schema"."table" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Comment
+		mock.ExpectExec(`COMMENT ON TABLE "database"."schema"."table" IS 'object comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockSourceTableScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockSourceTableScan(mock, pp)
+
+		// Query Definition
+		testhelpers.MockShowCreate(mock, `TABLE "database"."schema"."table"`,
+			`CREATE TABLE "database"."schema"."table" ("a" "pg_catalog"."text") FROM SOURCE "database"."schema"."source" (REFERENCE = "postgres"."public"."upstream_table") WITH (TEXT COLUMNS = ("a"), EXCLUDE COLUMNS = ("b"), DETAILS = '00')`,
+		)
+
+		if err := sourceTableCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u1", d.Id())
+		r.Equal("upstream_table", d.Get("upstream_name"))
+		r.Equal("public", d.Get("upstream_schema_name"))
+		r.Equal([]interface{}{"a"}, d.Get("text_columns"))
+		r.Equal([]interface{}{"b"}, d.Get("exclude_columns"))
+		r.Equal("database", d.Get("source.0.database_name"))
+	})
+}
+
+func TestResourceSourceTableKafkaCreate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "table",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"source":        []interface{}{map[string]interface{}{"name": "source"}},
+		"upstream_name": "topic",
+		"format":        []interface{}{map[string]interface{}{"json": true}},
+		"envelope":      []interface{}{map[string]interface{}{"none": true}},
+	}
+	d := schema.TestResourceDataRaw(t, SourceTable().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "materialize"."public"."source"
+			\(REFERENCE "topic"\) FORMAT JSON ENVELOPE NONE;`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_tables.name = 'table'`
+		testhelpers.MockSourceTableScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockSourceTableScan(mock, pp)
+
+		// Query Definition
+		testhelpers.MockShowCreate(mock, `TABLE "database"."schema"."table"`,
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "database"."schema"."source" (REFERENCE = "topic") FORMAT JSON ENVELOPE NONE`,
+		)
+
+		if err := sourceTableCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("topic", d.Get("upstream_name"))
+		r.Equal(true, d.Get("format.0.json"))
+		r.Equal(true, d.Get("envelope.0.none"))
+	})
+}
+
+func TestResourceSourceTableUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceTable().Schema, inSourceTable)
+	r.NotNil(d)
+
+	// Set current state
+	d.SetId("u1")
+	d.Set("name", "old_table")
+	r.NoError(d.Set("ownership_role", "old_role"))
+	r.NoError(d.Set("comment", "old comment"))
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER TABLE "database"."schema"."" RENAME TO "table";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER TABLE "database"."schema"."old_table" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`COMMENT ON TABLE "database"."schema"."old_table" IS 'object comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_tables.id = 'u1'`
+		testhelpers.MockSourceTableScan(mock, pp)
+
+		// Query Definition
+		testhelpers.MockShowCreate(mock, `TABLE "database"."schema"."table"`,
+			`CREATE TABLE "database"."schema"."table" FROM SOURCE "database"."schema"."source" (REFERENCE = "postgres"."public"."upstream_table")`,
+		)
+
+		if err := sourceTableUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSourceTableDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SourceTable().Schema, inSourceTable)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP TABLE "database"."schema"."table";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := sourceTableDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
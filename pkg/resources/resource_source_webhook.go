@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
 )
 
 var sourceWebhookSchema = map[string]*schema.Schema{
@@ -99,6 +101,26 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		MaxItems: 1,
 		ForceNew: true,
 	},
+	"validation_preset": {
+		Description: "Validate the requests sent by a vendor instead of writing the `check_options` and `check_expression`. The signature of Segment, Stripe and GitHub requests is verified with the signing secret. The GitHub preset includes the `x-github-event` and `x-github-delivery` headers as the `event_type` and `delivery_id` columns. `SHARED_SECRET` does not verify a signature: it compares the `authorization` header to the secret, such as `Basic <credentials>` for the basic authentication of Twilio, SendGrid or RudderStack.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"vendor": {
+					Description:  fmt.Sprintf("The vendor sending the requests, or `SHARED_SECRET` for a shared secret header check: %s.", materialize.WebhookPresetVendors),
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(materialize.WebhookPresetVendors, true),
+				},
+				"secret": IdentifierSchema("secret", "The secret holding the signing secret or the credentials of the vendor.", true),
+			},
+		},
+		MinItems:      1,
+		MaxItems:      1,
+		ForceNew:      true,
+		ConflictsWith: []string{"check_options", "check_expression"},
+	},
 	"check_options": {
 		Description: "The check options for the webhook.",
 		Type:        schema.TypeList,
@@ -120,7 +142,7 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 								Type:        schema.TypeBool,
 								Optional:    true,
 							},
-							"secret": IdentifierSchema("secret", "The secret for the check options.", false),
+							"secret": IdentifierSchema("secret", "The secret for the check options. To rotate the secret without recreating the source, update the value of the secret instead of referencing another secret.", false),
 						},
 					},
 					MinItems: 1,
@@ -146,6 +168,15 @@ var sourceWebhookSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
+		// Materialize reformats the expression it stores
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return materialize.EquivalentSql(old, new)
+		},
+	},
+	"url": {
+		Description: "The HTTPS URL to send requests to the webhook source.",
+		Type:        schema.TypeString,
+		Computed:    true,
 	},
 	"subsource":      SubsourceSchema(),
 	"ownership_role": OwnershipRoleSchema(),
@@ -157,7 +188,7 @@ func SourceWebhook() *schema.Resource {
 		Description: "**Private Preview** A webhook source describes a webhook you want Materialize to read data from.",
 
 		CreateContext: sourceWebhookCreate,
-		ReadContext:   sourceRead,
+		ReadContext:   sourceWebhookRead,
 		UpdateContext: sourceUpdate,
 		DeleteContext: sourceDelete,
 
@@ -169,6 +200,103 @@ func SourceWebhook() *schema.Resource {
 	}
 }
 
+func sourceWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := sourceRead(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s, err := materialize.ScanSourceWebhook(metaDb, utils.ExtractId(d.Id()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("body_format", preserveState(s.BodyFormat, d.Get("body_format"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("url", s.Url); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The headers included by the preset are not configured
+	var presetHeaders []materialize.HeaderStruct
+	if v, ok := d.GetOk("validation_preset"); ok {
+		vendor, secret := getValidationPreset(v)
+		if p, err := materialize.GetWebhookPreset(vendor, secret); err == nil {
+			presetHeaders = p.IncludeHeader
+		}
+	}
+
+	headers := []interface{}{}
+	for _, h := range s.IncludeHeader {
+		if slices.Contains(presetHeaders, h) {
+			continue
+		}
+		headers = append(headers, map[string]interface{}{
+			"header": h.Header,
+			"alias":  h.Alias,
+			"bytes":  h.Bytes,
+		})
+	}
+	if err := d.Set("include_header", headers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	includeHeaders := []interface{}{}
+	if i := s.IncludeHeaders; i.All || len(i.Only) > 0 || len(i.Not) > 0 {
+		includeHeaders = append(includeHeaders, map[string]interface{}{
+			"all":  i.All,
+			"only": i.Only,
+			"not":  i.Not,
+		})
+	}
+	if err := d.Set("include_headers", includeHeaders); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The check of a preset is generated by the provider
+	if _, ok := d.GetOk("validation_preset"); ok {
+		return nil
+	}
+
+	if err := d.Set("check_options", flattenCheckOptions(s.CheckOptions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep the expression as written when Materialize only reformatted it
+	checkExpression := s.CheckExpression
+	if v := d.Get("check_expression").(string); materialize.EquivalentSql(v, checkExpression) {
+		checkExpression = v
+	}
+	if err := d.Set("check_expression", checkExpression); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenCheckOptions(options []materialize.CheckOptionsStruct) []interface{} {
+	o := []interface{}{}
+	for _, c := range options {
+		o = append(o, map[string]interface{}{
+			"field": []interface{}{
+				map[string]interface{}{
+					"body":    c.Field.Body,
+					"headers": c.Field.Headers,
+					"secret":  flattenIdentifierSchema(c.Field.Secret),
+				},
+			},
+			"alias": c.Alias,
+			"bytes": c.Bytes,
+		})
+	}
+	return o
+}
+
 func sourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourceName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -245,6 +373,10 @@ func sourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 		b.CheckOptions(options)
 	}
+	if v, ok := d.GetOk("validation_preset"); ok {
+		b.ValidationPreset(getValidationPreset(v))
+	}
+
 	// Create resource
 	if err := b.Create(); err != nil {
 		return diag.FromErr(err)
@@ -279,5 +411,10 @@ func sourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	d.SetId(utils.TransformIdWithRegion(string(region), i))
 
-	return sourceRead(ctx, d, meta)
+	return sourceWebhookRead(ctx, d, meta)
+}
+
+func getValidationPreset(v interface{}) (string, materialize.IdentifierSchemaStruct) {
+	p := v.([]interface{})[0].(map[string]interface{})
+	return p["vendor"].(string), materialize.GetIdentifierSchemaStruct(p["secret"])
 }
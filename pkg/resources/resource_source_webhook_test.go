@@ -63,8 +63,109 @@ func TestResourceSourceWebhookCreate(t *testing.T) {
 		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
 		testhelpers.MockSubsourceScan(mock, ps)
 
+		// Query Definition
+		testhelpers.MockSourceWebhookScan(mock, pp)
+
 		if err := sourceWebhookCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
+
+		r.Equal("https://abc123.us-east-1.aws.materialize.cloud/api/webhook/database/schema/source", d.Get("url"))
+		r.Equal("bytes", d.Get("check_options.0.alias"))
+		r.Equal(true, d.Get("check_options.0.field.0.body"))
+		r.Equal("headers", d.Get("check_options.1.alias"))
+		r.Equal(true, d.Get("check_options.1.field.0.headers"))
+		r.Equal("check_expression", d.Get("check_expression"))
 	})
 }
+
+func TestResourceSourceWebhookPresetCreate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":          "webhook_source",
+		"schema_name":   "schema",
+		"database_name": "database",
+		"cluster_name":  "cluster",
+		"body_format":   "JSON",
+		"validation_preset": []interface{}{map[string]interface{}{
+			"vendor": "GITHUB",
+			"secret": []interface{}{map[string]interface{}{
+				"name":          "github_secret",
+				"schema_name":   "schema",
+				"database_name": "database",
+			}},
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, SourceWebhook().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE SOURCE "database"."schema"."webhook_source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON INCLUDE HEADER 'x-github-event' AS event_type INCLUDE HEADER 'x-github-delivery' AS delivery_id CHECK \( WITH \(BODY BYTES, HEADERS, SECRET "database"."schema"."github_secret" AS secret BYTES\) constant_time_eq\(decode\(substring\(headers->'x-hub-signature-256', 8\), 'hex'\), hmac\(body, secret, 'sha256'\)\)\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Id
+		ip := `WHERE mz_databases.name = 'database' AND mz_schemas.name = 'schema' AND mz_sources.name = 'webhook_source'`
+		testhelpers.MockSourceScan(mock, ip)
+
+		// Query Params
+		pp := `WHERE mz_sources.id = 'u1'`
+		testhelpers.MockSourceScan(mock, pp)
+
+		// Query Subsources
+		ps := `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`
+		testhelpers.MockSubsourceScan(mock, ps)
+
+		// Query Definition
+		testhelpers.MockSourceScan(mock, pp)
+		testhelpers.MockShowCreate(mock, `SOURCE "database"."schema"."source"`,
+			`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON INCLUDE HEADER 'x-github-event' AS "event_type" INCLUDE HEADER 'x-github-delivery' AS "delivery_id" CHECK (WITH (BODY BYTES, HEADERS, SECRET "database"."schema"."github_secret" AS "secret" BYTES) constant_time_eq(decode(substring(headers->'x-hub-signature-256', 8), 'hex'), hmac(body, secret, 'sha256')))`,
+		)
+		testhelpers.MockSourceWebhookUrlScan(mock)
+
+		if err := sourceWebhookCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		// The headers of the preset are not reported as configured headers
+		r.Equal(0, d.Get("include_header.#"))
+	})
+}
+
+func TestResourceSourceWebhookReadCheckExpression(t *testing.T) {
+	r := require.New(t)
+
+	for _, tc := range []struct {
+		configured string
+		expected   string
+	}{
+		// Only reformatted by Materialize
+		{"CHECK_EXPRESSION", "CHECK_EXPRESSION"},
+		// Changed outside of Terraform
+		{"other_expression", "check_expression"},
+	} {
+		t.Run(tc.configured, func(t *testing.T) {
+			in := map[string]interface{}{}
+			for k, v := range inSourceWebhook {
+				in[k] = v
+			}
+			in["check_expression"] = tc.configured
+			d := schema.TestResourceDataRaw(t, SourceWebhook().Schema, in)
+			d.SetId("aws/us-east-1:u1")
+
+			testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+				pp := `WHERE mz_sources.id = 'u1'`
+				testhelpers.MockSourceScan(mock, pp)
+				testhelpers.MockSubsourceScan(mock, `WHERE mz_object_dependencies.object_id = 'u1' AND mz_objects.type = 'source'`)
+				testhelpers.MockSourceWebhookScan(mock, pp)
+
+				if err := sourceWebhookRead(context.TODO(), d, db); err != nil {
+					t.Fatal(err)
+				}
+
+				r.Equal(tc.expected, d.Get("check_expression"))
+			})
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package resources
+
+import (
+	"context"
+	"log"
+	"reflect"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sqlStatementSchema = map[string]*schema.Schema{
+	"name": {
+		Description: "A unique name to identify the statement within the region.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"create_sql": {
+		Description: "The SQL statement to run on create. Changing it recreates the resource unless `update_sql` is set.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"update_sql": {
+		Description: "The SQL statement to run in place when `create_sql` or `update_sql` change, such as an `ALTER` statement.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"destroy_sql": {
+		Description: "The SQL statement to run on destroy.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"read_sql": {
+		Description: "A query to detect drift. If it returns no rows on refresh the resource is considered deleted, and applying fails if it returns no rows after the statement ran. If its result changes from the one read after the last apply, `drift_detected` is set and the next apply runs `update_sql`, or recreates the resource when `update_sql` is not set. The order of the rows does not matter.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"read_result": {
+		Description: "The rows returned by `read_sql`, with every value formatted as a string.",
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeMap, Elem: &schema.Schema{Type: schema.TypeString}},
+		Computed:    true,
+	},
+	"drift_detected": {
+		Description: "Whether the result of `read_sql` changed since the last apply.",
+		Type:        schema.TypeBool,
+		Computed:    true,
+	},
+	"region": RegionSchema(),
+}
+
+func SqlStatement() *schema.Resource {
+	return &schema.Resource{
+		Description: "An escape hatch to manage objects the provider does not support yet with plain SQL statements.",
+
+		CreateContext: sqlStatementCreate,
+		ReadContext:   sqlStatementRead,
+		UpdateContext: sqlStatementUpdate,
+		DeleteContext: sqlStatementDelete,
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.Id() == "" {
+				return nil
+			}
+			if d.HasChange("create_sql") && d.Get("update_sql").(string) == "" {
+				return d.ForceNew("create_sql")
+			}
+			if d.Get("drift_detected").(bool) {
+				// Run the statement again to correct the drift
+				if err := d.SetNew("drift_detected", false); err != nil {
+					return err
+				}
+				if d.Get("update_sql").(string) == "" {
+					return d.ForceNew("drift_detected")
+				}
+			}
+			return nil
+		},
+
+		Schema: sqlStatementSchema,
+	}
+}
+
+func sqlStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return sqlStatementRefresh(d, meta, true)
+}
+
+// sqlStatementRefresh stores the result of the `read_sql` query. On refresh,
+// a statement without rows is removed from state and a changed result is
+// flagged as drift. After an apply, a missing result is an error and the drift
+// flag is cleared.
+func sqlStatementRefresh(d *schema.ResourceData, meta interface{}, refresh bool) diag.Diagnostics {
+	q := d.Get("read_sql").(string)
+	if q == "" {
+		return nil
+	}
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rows, err := materialize.QuerySqlStatement(metaDb, q)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(rows) == 0 {
+		if !refresh {
+			return diag.Errorf("read_sql returned no rows after running the statement %s", d.Get("name").(string))
+		}
+		log.Printf("[DEBUG] read_sql returned no rows, removing statement from state: %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var result []interface{}
+	for _, r := range rows {
+		m := map[string]interface{}{}
+		for k, v := range r {
+			m[k] = v
+		}
+		result = append(result, m)
+	}
+
+	prior := d.Get("read_result").([]interface{})
+	if !refresh {
+		if err := d.Set("drift_detected", false); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if len(prior) > 0 && !reflect.DeepEqual(prior, result) {
+		log.Printf("[DEBUG] read_sql result changed, marking statement for update: %s", d.Id())
+		if err := d.Set("drift_detected", true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("read_result", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func sqlStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b := materialize.NewSqlStatementBuilder(metaDb)
+	if err := b.Exec(d.Get("create_sql").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), d.Get("name").(string)))
+
+	return sqlStatementRefresh(d, meta, false)
+}
+
+func sqlStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("create_sql", "update_sql", "drift_detected") {
+		if s := d.Get("update_sql").(string); s != "" {
+			b := materialize.NewSqlStatementBuilder(metaDb)
+			if err := b.Exec(s); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return sqlStatementRefresh(d, meta, false)
+}
+
+func sqlStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b := materialize.NewSqlStatementBuilder(metaDb)
+	if err := b.Exec(d.Get("destroy_sql").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
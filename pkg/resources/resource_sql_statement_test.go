@@ -0,0 +1,164 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var inSqlStatement = map[string]interface{}{
+	"name":        "mysql_connection",
+	"create_sql":  "CREATE CONNECTION mysql_connection TO MYSQL (HOST 'localhost')",
+	"update_sql":  "ALTER CONNECTION mysql_connection SET (HOST 'localhost')",
+	"destroy_sql": "DROP CONNECTION mysql_connection",
+	"read_sql":    "SELECT id FROM mz_connections WHERE name = 'mysql_connection'",
+}
+
+func TestResourceSqlStatementCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SqlStatement().Schema, inSqlStatement)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE CONNECTION mysql_connection TO MYSQL \(HOST 'localhost'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Read
+		ir := mock.NewRows([]string{"id"}).AddRow("u1")
+		mock.ExpectQuery(`SELECT id FROM mz_connections WHERE name = 'mysql_connection'`).WillReturnRows(ir)
+
+		if err := sqlStatementCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:mysql_connection", d.Id())
+		r.Equal("u1", d.Get("read_result.0.id"))
+	})
+}
+
+func TestResourceSqlStatementReadDrift(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SqlStatement().Schema, inSqlStatement)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:mysql_connection")
+	r.NoError(d.Set("read_result", []interface{}{map[string]interface{}{"id": "u1"}}))
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Read
+		ir := mock.NewRows([]string{"id"}).AddRow("u2")
+		mock.ExpectQuery(`SELECT id FROM mz_connections WHERE name = 'mysql_connection'`).WillReturnRows(ir)
+
+		if err := sqlStatementRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal(true, d.Get("drift_detected"))
+		r.Equal(inSqlStatement["create_sql"], d.Get("create_sql"))
+		r.Equal("u2", d.Get("read_result.0.id"))
+	})
+}
+
+func TestResourceSqlStatementReadOrder(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SqlStatement().Schema, inSqlStatement)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:mysql_connection")
+	r.NoError(d.Set("read_result", []interface{}{
+		map[string]interface{}{"id": "u1"},
+		map[string]interface{}{"id": "u2"},
+	}))
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Read
+		ir := mock.NewRows([]string{"id"}).AddRow("u2").AddRow("u1")
+		mock.ExpectQuery(`SELECT id FROM mz_connections WHERE name = 'mysql_connection'`).WillReturnRows(ir)
+
+		if err := sqlStatementRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal(false, d.Get("drift_detected"))
+	})
+}
+
+func TestResourceSqlStatementReadMissing(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SqlStatement().Schema, inSqlStatement)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:mysql_connection")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Read
+		ir := mock.NewRows([]string{"id"})
+		mock.ExpectQuery(`SELECT id FROM mz_connections WHERE name = 'mysql_connection'`).WillReturnRows(ir)
+
+		if err := sqlStatementRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("", d.Id())
+	})
+}
+
+func TestResourceSqlStatementCreateMissing(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SqlStatement().Schema, inSqlStatement)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Create
+		mock.ExpectExec(
+			`CREATE CONNECTION mysql_connection TO MYSQL \(HOST 'localhost'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Read
+		ir := mock.NewRows([]string{"id"})
+		mock.ExpectQuery(`SELECT id FROM mz_connections WHERE name = 'mysql_connection'`).WillReturnRows(ir)
+
+		r.True(sqlStatementCreate(context.TODO(), d, db).HasError())
+	})
+}
+
+func TestResourceSqlStatementUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SqlStatement().Schema, inSqlStatement)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:mysql_connection")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Update
+		mock.ExpectExec(
+			`ALTER CONNECTION mysql_connection SET \(HOST 'localhost'\);`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Read
+		ir := mock.NewRows([]string{"id"}).AddRow("u1")
+		mock.ExpectQuery(`SELECT id FROM mz_connections WHERE name = 'mysql_connection'`).WillReturnRows(ir)
+
+		if err := sqlStatementUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSqlStatementDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SqlStatement().Schema, inSqlStatement)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP CONNECTION mysql_connection;`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := sqlStatementDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func SSOConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "The SSO configuration of the organization, to sign in through a SAML or OIDC identity provider.",
+
+		CreateContext: ssoConfigCreate,
+		ReadContext:   ssoConfigRead,
+		UpdateContext: ssoConfigUpdate,
+		DeleteContext: ssoConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"saml", "oidc"}, false),
+				Description:  "The protocol of the identity provider. Allowed values are 'saml' and 'oidc'.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether users can sign in through the identity provider.",
+			},
+			"sso_endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The sign in URL of the identity provider.",
+			},
+			"public_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded certificate the identity provider signs SAML responses with.",
+			},
+			"sign_request": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether SAML requests to the identity provider are signed.",
+			},
+			"oidc_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The client ID of the OIDC application.",
+			},
+			"oidc_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client secret of the OIDC application.",
+			},
+			"acs_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The assertion consumer service URL to configure in the identity provider.",
+			},
+			"sp_entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service provider entity ID to configure in the identity provider.",
+			},
+		},
+	}
+}
+
+// SSOConfigResponse represents an SSO configuration in the Frontegg API.
+type SSOConfigResponse struct {
+	ID                string              `json:"id"`
+	Enabled           bool                `json:"enabled"`
+	SsoEndpoint       string              `json:"ssoEndpoint"`
+	PublicCertificate string              `json:"publicCertificate"`
+	SignRequest       bool                `json:"signRequest"`
+	AcsUrl            string              `json:"acsUrl"`
+	SpEntityId        string              `json:"spEntityId"`
+	Type              string              `json:"type"`
+	OidcClientId      string              `json:"oidcClientId"`
+	OidcSecret        string              `json:"oidcSecret"`
+	Domains           []SSODomainResponse `json:"domains"`
+}
+
+// SSOConfigRequest is used to serialize the request body for creating or updating an SSO configuration.
+type SSOConfigRequest struct {
+	Enabled           bool   `json:"enabled"`
+	SsoEndpoint       string `json:"ssoEndpoint"`
+	PublicCertificate string `json:"publicCertificate,omitempty"`
+	SignRequest       bool   `json:"signRequest"`
+	Type              string `json:"type"`
+	OidcClientId      string `json:"oidcClientId,omitempty"`
+	OidcSecret        string `json:"oidcSecret,omitempty"`
+}
+
+func ssoConfigRequest(d *schema.ResourceData) SSOConfigRequest {
+	r := SSOConfigRequest{
+		Enabled:      d.Get("enabled").(bool),
+		SsoEndpoint:  d.Get("sso_endpoint").(string),
+		SignRequest:  d.Get("sign_request").(bool),
+		Type:         d.Get("type").(string),
+		OidcClientId: d.Get("oidc_client_id").(string),
+		OidcSecret:   d.Get("oidc_secret").(string),
+	}
+	// The API expects the certificate to be base64 encoded
+	if c := d.Get("public_certificate").(string); c != "" {
+		r.PublicCertificate = base64.StdEncoding.EncodeToString([]byte(c))
+	}
+	return r
+}
+
+func ssoConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var config SSOConfigResponse
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "POST", ssoConfigurationsPath, ssoConfigRequest(d), &config); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating SSO configuration: %s", err))
+	}
+
+	d.SetId(config.ID)
+	return ssoConfigRead(ctx, d, meta)
+}
+
+func ssoConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, err := getSSOConfig(ctx, providerMeta, d.Id())
+	if errors.Is(err, errFronteggNotFound) {
+		log.Printf("[WARN] SSO configuration %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	certificate, err := base64.StdEncoding.DecodeString(config.PublicCertificate)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding public certificate: %s", err))
+	}
+
+	if err := setAttributes(d, map[string]interface{}{
+		"type":               config.Type,
+		"enabled":            config.Enabled,
+		"sso_endpoint":       config.SsoEndpoint,
+		"public_certificate": string(certificate),
+		"sign_request":       config.SignRequest,
+		"oidc_client_id":     config.OidcClientId,
+		"acs_url":            config.AcsUrl,
+		"sp_entity_id":       config.SpEntityId,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func ssoConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := fmt.Sprintf("%s/%s", ssoConfigurationsPath, d.Id())
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "PATCH", path, ssoConfigRequest(d), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating SSO configuration: %s", err))
+	}
+
+	return ssoConfigRead(ctx, d, meta)
+}
+
+func ssoConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := fmt.Sprintf("%s/%s", ssoConfigurationsPath, d.Id())
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "DELETE", path, nil, nil); err != nil && !errors.Is(err, errFronteggNotFound) {
+		return diag.FromErr(fmt.Errorf("error deleting SSO configuration: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getSSOConfig finds an SSO configuration by ID. The API only lists
+// configurations.
+func getSSOConfig(ctx context.Context, providerMeta *utils.ProviderMeta, id string) (SSOConfigResponse, error) {
+	var configs []SSOConfigResponse
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "GET", ssoConfigurationsPath, nil, &configs); err != nil {
+		return SSOConfigResponse{}, fmt.Errorf("error listing SSO configurations: %s", err)
+	}
+
+	for _, c := range configs {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return SSOConfigResponse{}, errFronteggNotFound
+}
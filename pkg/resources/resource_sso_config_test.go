@@ -0,0 +1,66 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func fronteggProviderMeta(serverURL string) *utils.ProviderMeta {
+	return &utils.ProviderMeta{
+		Frontegg: &clients.FronteggClient{
+			Endpoint:    serverURL,
+			HTTPClient:  &http.Client{},
+			TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestSSOConfigResource(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		providerMeta := fronteggProviderMeta(serverURL)
+
+		in := map[string]interface{}{
+			"type":               "saml",
+			"sso_endpoint":       "https://idp.example.com/sso",
+			"public_certificate": "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----",
+		}
+		d := schema.TestResourceDataRaw(t, SSOConfig().Schema, in)
+
+		diags := ssoConfigCreate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.NotEmpty(d.Id())
+		r.Equal(true, d.Get("enabled"))
+		r.Equal("https://idp.example.com/sso", d.Get("sso_endpoint"))
+		r.Equal(in["public_certificate"], d.Get("public_certificate"))
+		r.Equal("https://auth.materialize.com/auth/saml/callback", d.Get("acs_url"))
+		r.NotEmpty(d.Get("sp_entity_id"))
+
+		id := d.Id()
+		in["sso_endpoint"] = "https://idp.example.com/sso/v2"
+		d = schema.TestResourceDataRaw(t, SSOConfig().Schema, in)
+		d.SetId(id)
+		diags = ssoConfigUpdate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Equal("https://idp.example.com/sso/v2", d.Get("sso_endpoint"))
+
+		diags = ssoConfigDelete(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Empty(d.Id())
+
+		// The configuration no longer exists
+		d.SetId(id)
+		diags = ssoConfigRead(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Empty(d.Id())
+	})
+}
@@ -0,0 +1,194 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SSODomain() *schema.Resource {
+	return &schema.Resource{
+		Description: "A domain of an SSO configuration. Users with an email address in a validated domain sign in through the identity provider.",
+
+		CreateContext: ssoDomainCreate,
+		ReadContext:   ssoDomainRead,
+		UpdateContext: ssoDomainUpdate,
+		DeleteContext: ssoDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ssoDomainImport,
+		},
+
+		// Retry the validation on every apply until it succeeds
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.Id() != "" && d.Get("validate").(bool) && !d.Get("validated").(bool) {
+				return d.SetNewComputed("validated")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sso_config_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the SSO configuration.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The domain name, such as `example.com`.",
+			},
+			"validate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to validate the domain. The `txt_record` must be published in the DNS of the domain before it can be validated.",
+			},
+			"validated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the domain has been validated.",
+			},
+			"txt_record": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The DNS TXT record that proves the ownership of the domain.",
+			},
+		},
+	}
+}
+
+// SSODomainResponse represents a domain of an SSO configuration in the Frontegg API.
+type SSODomainResponse struct {
+	ID          string `json:"id"`
+	Domain      string `json:"domain"`
+	Validated   bool   `json:"validated"`
+	SsoConfigId string `json:"ssoConfigId"`
+	TxtRecord   string `json:"txtRecord"`
+}
+
+func ssoDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configID := d.Get("sso_config_id").(string)
+	path := fmt.Sprintf("%s/%s/domains", ssoConfigurationsPath, configID)
+	body := map[string]string{"domain": d.Get("domain").(string)}
+
+	var domain SSODomainResponse
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "POST", path, body, &domain); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating SSO domain: %s", err))
+	}
+	d.SetId(domain.ID)
+
+	// A failed validation does not fail the create, the TXT record of the new
+	// domain is only known once it exists.
+	var diags diag.Diagnostics
+	if d.Get("validate").(bool) {
+		if err := validateSSODomain(ctx, providerMeta, configID, domain.ID); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("SSO domain %s is not validated", domain.Domain),
+				Detail:   fmt.Sprintf("Publish the txt_record in the DNS of the domain and apply again: %s", err),
+			})
+		}
+	}
+
+	return append(diags, ssoDomainRead(ctx, d, meta)...)
+}
+
+func ssoDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, err := getSSOConfig(ctx, providerMeta, d.Get("sso_config_id").(string))
+	if err != nil && !errors.Is(err, errFronteggNotFound) {
+		return diag.FromErr(err)
+	}
+
+	for _, domain := range config.Domains {
+		if domain.ID != d.Id() {
+			continue
+		}
+
+		if err := setAttributes(d, map[string]interface{}{
+			"domain":     domain.Domain,
+			"validated":  domain.Validated,
+			"txt_record": domain.TxtRecord,
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] SSO domain %s not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func ssoDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("validate").(bool) && !d.Get("validated").(bool) {
+		if err := validateSSODomain(ctx, providerMeta, d.Get("sso_config_id").(string), d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ssoDomainRead(ctx, d, meta)
+}
+
+func ssoDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := fmt.Sprintf("%s/%s/domains/%s", ssoConfigurationsPath, d.Get("sso_config_id").(string), d.Id())
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "DELETE", path, nil, nil); err != nil && !errors.Is(err, errFronteggNotFound) {
+		return diag.FromErr(fmt.Errorf("error deleting SSO domain: %s", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// ssoDomainImport imports a domain from an ID of the form
+// `<sso_config_id>:<domain_id>`.
+func ssoDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected ID format %q, expected <sso_config_id>:<domain_id>", d.Id())
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("sso_config_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateSSODomain checks the TXT record of a domain.
+func validateSSODomain(ctx context.Context, providerMeta *utils.ProviderMeta, configID, domainID string) error {
+	path := fmt.Sprintf("%s/%s/domains/%s/validate", ssoConfigurationsPath, configID, domainID)
+	if err := fronteggRequest(ctx, providerMeta.Frontegg, "PUT", path, nil, nil); err != nil {
+		return fmt.Errorf("error validating SSO domain: %s", err)
+	}
+	return nil
+}
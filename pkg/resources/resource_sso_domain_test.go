@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSODomainResource(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		providerMeta := fronteggProviderMeta(serverURL)
+
+		c := schema.TestResourceDataRaw(t, SSOConfig().Schema, map[string]interface{}{
+			"type":         "oidc",
+			"sso_endpoint": "https://idp.materialize.com",
+		})
+		r.False(ssoConfigCreate(context.TODO(), c, providerMeta).HasError())
+
+		in := map[string]interface{}{
+			"sso_config_id": c.Id(),
+			"domain":        "materialize.com",
+			"validate":      true,
+		}
+		d := schema.TestResourceDataRaw(t, SSODomain().Schema, in)
+
+		diags := ssoDomainCreate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.NotEmpty(d.Id())
+		r.Equal(true, d.Get("validated"))
+		r.NotEmpty(d.Get("txt_record"))
+
+		diags = ssoDomainDelete(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Empty(d.Id())
+	})
+}
+
+func TestSSODomainResourceValidationFailure(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		providerMeta := fronteggProviderMeta(serverURL)
+
+		c := schema.TestResourceDataRaw(t, SSOConfig().Schema, map[string]interface{}{
+			"type":         "oidc",
+			"sso_endpoint": "https://idp.example.com",
+		})
+		r.False(ssoConfigCreate(context.TODO(), c, providerMeta).HasError())
+
+		in := map[string]interface{}{
+			"sso_config_id": c.Id(),
+			"domain":        "example.com",
+			"validate":      true,
+		}
+		d := schema.TestResourceDataRaw(t, SSODomain().Schema, in)
+
+		// The domain is created and the failed validation is a warning
+		diags := ssoDomainCreate(context.TODO(), d, providerMeta)
+		r.False(diags.HasError(), diags)
+		r.Len(diags, 1)
+		r.Equal(diag.Warning, diags[0].Severity)
+		r.NotEmpty(d.Id())
+		r.Equal(false, d.Get("validated"))
+
+		// Retrying the validation on update fails
+		diags = ssoDomainUpdate(context.TODO(), d, providerMeta)
+		r.True(diags.HasError())
+	})
+}
+
+func TestSSODomainResourceImport(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, SSODomain().Schema, nil)
+	d.SetId("sso-1:domain-2")
+
+	result, err := ssoDomainImport(context.TODO(), d, nil)
+	r.NoError(err)
+	r.Len(result, 1)
+	r.Equal("domain-2", d.Id())
+	r.Equal("sso-1", d.Get("sso_config_id"))
+
+	d.SetId("domain-2")
+	_, err = ssoDomainImport(context.TODO(), d, nil)
+	r.Error(err)
+}
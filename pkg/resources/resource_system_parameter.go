@@ -0,0 +1,189 @@
+package resources
+
+import (
+	"context"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var systemParameterSchema = map[string]*schema.Schema{
+	"name": {
+		Description:  "The name of the system parameter, such as `max_clusters`.",
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	},
+	"value": {
+		Description: "The value of the system parameter. Differences in how Materialize reports the value, such as `on` for `true` or `1GB` for `1024MB`, are ignored.",
+		Type:        schema.TypeString,
+		Required:    true,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return normalizeSystemParameterValue(old) == normalizeSystemParameterValue(new)
+		},
+	},
+	"region": RegionSchema(),
+}
+
+func SystemParameter() *schema.Resource {
+	return &schema.Resource{
+		Description: "A system parameter sets a region-wide configuration, such as the limits on the number of objects, with `ALTER SYSTEM SET`. The parameter is reset to its default value when the resource is destroyed. Requires a superuser.",
+
+		CreateContext: systemParameterCreate,
+		ReadContext:   systemParameterRead,
+		UpdateContext: systemParameterUpdate,
+		DeleteContext: systemParameterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: systemParameterSchema,
+	}
+}
+
+func systemParameterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The ID is the name of the parameter
+	name := utils.ExtractId(i)
+	value, err := materialize.ScanSystemParameter(metaDb, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("value", value); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func systemParameterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b := materialize.NewSystemParameterBuilder(metaDb, name)
+	if err := b.Set(d.Get("value").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), name))
+
+	return systemParameterRead(ctx, d, meta)
+}
+
+func systemParameterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("value") {
+		b := materialize.NewSystemParameterBuilder(metaDb, d.Get("name").(string))
+		if err := b.Set(d.Get("value").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return systemParameterRead(ctx, d, meta)
+}
+
+func systemParameterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b := materialize.NewSystemParameterBuilder(metaDb, d.Get("name").(string))
+	if err := b.Reset(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+var systemParameterBools = map[string]string{
+	"on":    "on",
+	"true":  "on",
+	"yes":   "on",
+	"off":   "off",
+	"false": "off",
+	"no":    "off",
+}
+
+// Durations and sizes, such as `10 s`, `100ms` or `1GB`
+var systemParameterUnitRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*(\pL+)$`)
+
+// Units of durations and sizes, in nanoseconds and bytes
+var systemParameterUnits = map[string]struct {
+	factor int64
+	kind   string
+}{
+	"ns":  {1, "duration"},
+	"us":  {1000, "duration"},
+	"µs":  {1000, "duration"},
+	"ms":  {1000000, "duration"},
+	"s":   {1000000000, "duration"},
+	"min": {60000000000, "duration"},
+	"h":   {3600000000000, "duration"},
+	"d":   {86400000000000, "duration"},
+	"b":   {1, "size"},
+	"kb":  {1 << 10, "size"},
+	"kib": {1 << 10, "size"},
+	"mb":  {1 << 20, "size"},
+	"mib": {1 << 20, "size"},
+	"gb":  {1 << 30, "size"},
+	"gib": {1 << 30, "size"},
+	"tb":  {1 << 40, "size"},
+	"tib": {1 << 40, "size"},
+}
+
+// normalizeSystemParameterValue returns the value of a system parameter in a
+// form that does not depend on how it was written: booleans as on or off,
+// durations in nanoseconds, sizes in bytes and numbers without trailing
+// zeros.
+func normalizeSystemParameterValue(v string) string {
+	n := strings.ToLower(strings.Join(strings.Fields(strings.Trim(strings.TrimSpace(v), "'")), " "))
+
+	if b, ok := systemParameterBools[n]; ok {
+		return b
+	}
+
+	if m := systemParameterUnitRegex.FindStringSubmatch(n); m != nil {
+		if unit, ok := systemParameterUnits[m[2]]; ok {
+			if r, ok := new(big.Rat).SetString(m[1]); ok {
+				return r.Mul(r, big.NewRat(unit.factor, 1)).RatString() + " " + unit.kind
+			}
+		}
+	}
+
+	if r, ok := new(big.Rat).SetString(n); ok {
+		return r.RatString()
+	}
+
+	return n
+}
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+var inSystemParameter = map[string]interface{}{
+	"name":  "max_clusters",
+	"value": "100",
+}
+
+func TestResourceSystemParameterCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, inSystemParameter)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SYSTEM SET "max_clusters" = '100';`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockSystemParameterScan(mock, "max_clusters", "100")
+
+		if err := systemParameterCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:max_clusters", d.Id())
+		r.Equal("100", d.Get("value"))
+	})
+}
+
+func TestResourceSystemParameterReadDrift(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, nil)
+	r.NotNil(d)
+
+	// Imported by name
+	d.SetId("aws/us-east-1:max_clusters")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		testhelpers.MockSystemParameterScan(mock, "max_clusters", "25")
+
+		if err := systemParameterRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("max_clusters", d.Get("name"))
+		r.Equal("25", d.Get("value"))
+	})
+}
+
+func TestResourceSystemParameterUpdate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, inSystemParameter)
+	r.NotNil(d)
+	d.SetId("aws/us-east-1:max_clusters")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SYSTEM SET "max_clusters" = '100';`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockSystemParameterScan(mock, "max_clusters", "100")
+
+		if err := systemParameterUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSystemParameterDelete(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, inSystemParameter)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER SYSTEM RESET "max_clusters";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := systemParameterDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceSystemParameterBooleanDiff(t *testing.T) {
+	r := require.New(t)
+
+	// Read back as reported by SHOW
+	d := schema.TestResourceDataRaw(t, SystemParameter().Schema, map[string]interface{}{
+		"name":   "enable_rbac_checks",
+		"value":  "on",
+		"region": "aws/us-east-1",
+	})
+	d.SetId("aws/us-east-1:enable_rbac_checks")
+	state := d.State()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "enable_rbac_checks",
+		"value":  "true",
+		"region": "aws/us-east-1",
+	})
+	diff, err := SystemParameter().Diff(context.TODO(), state, config, nil)
+	r.NoError(err)
+	r.Nil(diff)
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "enable_rbac_checks",
+		"value":  "false",
+		"region": "aws/us-east-1",
+	})
+	diff, err = SystemParameter().Diff(context.TODO(), state, config, nil)
+	r.NoError(err)
+	r.NotNil(diff)
+	r.Equal("false", diff.Attributes["value"].New)
+}
+
+func TestNormalizeSystemParameterValue(t *testing.T) {
+	r := require.New(t)
+
+	for _, tc := range [][2]string{
+		{"true", "on"},
+		{"ON", "on"},
+		{"'off'", "false"},
+		{"1s", "1000ms"},
+		{"1 min", "60s"},
+		{"1GB", "1024 MB"},
+		{"1.5", "1.50"},
+		{"Strict Serializable", "strict serializable"},
+	} {
+		r.Equal(normalizeSystemParameterValue(tc[0]), normalizeSystemParameterValue(tc[1]), tc)
+	}
+
+	for _, tc := range [][2]string{
+		{"true", "1"},
+		{"1s", "1"},
+		{"1s", "1b"},
+		{"100", "1000"},
+	} {
+		r.NotEqual(normalizeSystemParameterValue(tc[0]), normalizeSystemParameterValue(tc[1]), tc)
+	}
+}
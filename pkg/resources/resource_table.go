@@ -35,11 +35,7 @@ var tableSchema = map[string]*schema.Schema{
 					Required:    true,
 					ForceNew:    true,
 					StateFunc: func(val any) string {
-						alias, ok := aliases[val.(string)]
-						if ok {
-							return alias
-						}
-						return val.(string)
+						return typeAlias(val.(string))
 					},
 				},
 				"nullable": {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"strings"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
@@ -13,7 +14,7 @@ import (
 )
 
 var typeSchema = map[string]*schema.Schema{
-	"name":               ObjectNameSchema("type", true, true),
+	"name":               ObjectNameSchema("type", true, false),
 	"schema_name":        SchemaNameSchema("type", false),
 	"database_name":      DatabaseNameSchema("type", false),
 	"qualified_sql_name": QualifiedNameSchema("type"),
@@ -29,9 +30,10 @@ var typeSchema = map[string]*schema.Schema{
 					Required:    true,
 				},
 				"field_type": {
-					Description: "The data type of a field indicated by `FIELD NAME`.",
-					Type:        schema.TypeString,
-					Required:    true,
+					Description:      "The data type of a field indicated by `FIELD NAME`. Custom types can be referenced by the `qualified_sql_name` of a `materialize_type`.",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressTypeDiff,
 				},
 			},
 		},
@@ -46,9 +48,10 @@ var typeSchema = map[string]*schema.Schema{
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"element_type": {
-					Description: "Creates a custom list whose elements are of `ELEMENT TYPE`",
-					Type:        schema.TypeString,
-					Required:    true,
+					Description:      "Creates a custom list whose elements are of `ELEMENT TYPE`. Custom types can be referenced by the `qualified_sql_name` of a `materialize_type`.",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressTypeDiff,
 				},
 			},
 		},
@@ -64,14 +67,16 @@ var typeSchema = map[string]*schema.Schema{
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"key_type": {
-					Description: "Creates a custom map whose keys are of `KEY TYPE`. `KEY TYPE` must resolve to text.",
-					Type:        schema.TypeString,
-					Required:    true,
+					Description:      "Creates a custom map whose keys are of `KEY TYPE`. `KEY TYPE` must resolve to text.",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressTypeDiff,
 				},
 				"value_type": {
-					Description: "Creates a custom map whose values are of `VALUE TYPE`.",
-					Type:        schema.TypeString,
-					Required:    true,
+					Description:      "Creates a custom map whose values are of `VALUE TYPE`. Custom types can be referenced by the `qualified_sql_name` of a `materialize_type`.",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressTypeDiff,
 				},
 			},
 		},
@@ -153,6 +158,23 @@ func typeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		return diag.FromErr(err)
 	}
 
+	t, err := materialize.ScanTypeDefinition(metaDb, s)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("row_properties", flattenRowProperties(t.RowProperties)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("list_properties", flattenListProperties(t.ListProperties)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("map_properties", flattenMapProperties(t.MapProperties)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -230,10 +252,20 @@ func typeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 	o := materialize.MaterializeObject{ObjectType: "TYPE", Name: typeName, SchemaName: schemaName, DatabaseName: databaseName}
-	b := materialize.NewOwnershipBuilder(metaDb, o)
+
+	if d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		o := materialize.MaterializeObject{ObjectType: "TYPE", Name: oldName.(string), SchemaName: schemaName, DatabaseName: databaseName}
+		b := materialize.NewTypeBuilder(metaDb, o)
+
+		if err := b.Rename(newName.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	if d.HasChange("ownership_role") {
 		_, newRole := d.GetChange("ownership_role")
+		b := materialize.NewOwnershipBuilder(metaDb, o)
 
 		if err := b.Alter(newRole.(string)); err != nil {
 			return diag.FromErr(err)
@@ -269,3 +301,58 @@ func typeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 	}
 	return nil
 }
+
+func flattenRowProperties(properties []materialize.RowProperties) []interface{} {
+	rows := []interface{}{}
+	for _, p := range properties {
+		rows = append(rows, map[string]interface{}{"field_name": p.FieldName, "field_type": p.FieldType})
+	}
+	return rows
+}
+
+func flattenListProperties(properties []materialize.ListProperties) []interface{} {
+	lists := []interface{}{}
+	for _, p := range properties {
+		lists = append(lists, map[string]interface{}{"element_type": p.ElementType})
+	}
+	return lists
+}
+
+func flattenMapProperties(properties []materialize.MapProperties) []interface{} {
+	maps := []interface{}{}
+	for _, p := range properties {
+		maps = append(maps, map[string]interface{}{"key_type": p.KeyType, "value_type": p.ValueType})
+	}
+	return maps
+}
+
+// suppressTypeDiff ignores differences between the configured data type and
+// the type read back from the catalog that only come from aliases, quoting,
+// or qualifying custom types in the schema of the type.
+func suppressTypeDiff(k, old, new string, d *schema.ResourceData) bool {
+	schemaName := d.Get("schema_name").(string)
+	databaseName := d.Get("database_name").(string)
+	return normalizeTypeName(old, schemaName, databaseName) == normalizeTypeName(new, schemaName, databaseName)
+}
+
+// typeAlias returns the name of a built-in type given by one of its aliases.
+func typeAlias(t string) string {
+	if name, ok := aliases[t]; ok {
+		return name
+	}
+	return t
+}
+
+func normalizeTypeName(t, schemaName, databaseName string) string {
+	n := strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(t, `"`, ""))), " ")
+
+	for _, prefix := range []string{
+		"pg_catalog.",
+		"mz_catalog.",
+		strings.ToLower(databaseName + "." + schemaName + "."),
+	} {
+		n = strings.TrimPrefix(n, prefix)
+	}
+
+	return typeAlias(n)
+}
@@ -36,9 +36,14 @@ func TestResourceTypeCreate(t *testing.T) {
 		pp := `WHERE mz_types.id = 'u1'`
 		testhelpers.MockTypeScan(mock, pp)
 
+		// Query Element Type
+		testhelpers.MockListTypeScan(mock, `WHERE mz_list_types.id = 'u1'`)
+
 		if err := typeCreate(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
+
+		r.Equal("int4", d.Get("list_properties.0.element_type"))
 	})
 }
 
@@ -56,6 +61,9 @@ func TestResourceTypeReadIdMigration(t *testing.T) {
 		pp := `WHERE mz_types.id = 'u1'`
 		testhelpers.MockTypeScan(mock, pp)
 
+		// Query Element Type
+		testhelpers.MockListTypeScan(mock, `WHERE mz_list_types.id = 'u1'`)
+
 		if err := typeRead(context.TODO(), d, db); err != nil {
 			t.Fatal(err)
 		}
@@ -80,3 +88,138 @@ func TestResourceTypeDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestResourceTypeReadMap(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":           "type",
+		"schema_name":    "schema",
+		"database_name":  "database",
+		"map_properties": []interface{}{map[string]interface{}{"key_type": "text", "value_type": "nested"}},
+	}
+	d := schema.TestResourceDataRaw(t, Type().Schema, in)
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_types.id = 'u1'`
+		testhelpers.MockTypeCategoryScan(mock, pp, "map")
+
+		// Query Key and Value Types
+		testhelpers.MockMapTypeScan(mock, `WHERE mz_map_types.id = 'u1'`)
+
+		if err := typeRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("text", d.Get("map_properties.0.key_type"))
+		r.Equal(`"database"."schema"."nested"`, d.Get("map_properties.0.value_type"))
+		r.Empty(d.Get("list_properties"))
+		r.Empty(d.Get("row_properties"))
+	})
+}
+
+func TestResourceTypeReadRow(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Type().Schema, map[string]interface{}{"name": "type"})
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Params
+		pp := `WHERE mz_types.id = 'u1'`
+		testhelpers.MockTypeCategoryScan(mock, pp, "row")
+
+		// Query Definition
+		testhelpers.MockShowCreate(mock, `TYPE "database"."schema"."type"`,
+			`CREATE TYPE "database"."schema"."type" AS ("a" "pg_catalog"."int4", "b" "database"."schema"."nested")`)
+
+		if err := typeRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("a", d.Get("row_properties.0.field_name"))
+		r.Equal("int4", d.Get("row_properties.0.field_type"))
+		r.Equal("b", d.Get("row_properties.1.field_name"))
+		r.Equal("database.schema.nested", d.Get("row_properties.1.field_type"))
+		r.Empty(d.Get("list_properties"))
+	})
+}
+
+func TestResourceTypeUpdate(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{
+		"name":            "type",
+		"schema_name":     "schema",
+		"database_name":   "database",
+		"list_properties": []interface{}{map[string]interface{}{"element_type": "int4"}},
+		"ownership_role":  "joe",
+		"comment":         "comment",
+	}
+	d := schema.TestResourceDataRaw(t, Type().Schema, in)
+	r.NotNil(d)
+
+	// Set current state
+	d.SetId("u1")
+	d.Set("name", "old_type")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`ALTER TYPE "database"."schema"."" RENAME TO "type";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`ALTER TYPE "database"."schema"."old_type" OWNER TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`COMMENT ON TYPE "database"."schema"."old_type" IS 'comment';`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_types.id = 'u1'`
+		testhelpers.MockTypeScan(mock, pp)
+
+		// Query Element Type
+		testhelpers.MockListTypeScan(mock, `WHERE mz_list_types.id = 'u1'`)
+
+		if err := typeUpdate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestResourceTypeImport(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, Type().Schema, map[string]interface{}{"name": "type"})
+	r.NotNil(d)
+	d.SetId("u1")
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		s, err := Type().Importer.StateContext(context.TODO(), d, db)
+		r.NoError(err)
+		r.Len(s, 1)
+
+		// Query Params
+		pp := `WHERE mz_types.id = 'u1'`
+		testhelpers.MockTypeCategoryScan(mock, pp, "map")
+
+		// Query Key and Value Types
+		testhelpers.MockMapTypeScan(mock, `WHERE mz_map_types.id = 'u1'`)
+
+		if err := typeRead(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("text", d.Get("map_properties.0.key_type"))
+		r.Equal(`"database"."schema"."nested"`, d.Get("map_properties.0.value_type"))
+		r.Empty(d.Get("list_properties"))
+	})
+}
+
+func TestNormalizeTypeName(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("integer", normalizeTypeName("integer", "schema", "database"))
+	r.Equal("integer", normalizeTypeName(`"pg_catalog"."int4"`, "schema", "database"))
+	r.Equal("double precision", normalizeTypeName("DOUBLE  PRECISION", "schema", "database"))
+	r.Equal("double precision", normalizeTypeName("float8", "schema", "database"))
+	r.Equal("character varying", normalizeTypeName("varchar", "schema", "database"))
+	r.Equal("timestamp", normalizeTypeName("timestamp without time zone", "schema", "database"))
+	r.Equal("nested", normalizeTypeName(`"database"."schema"."nested"`, "schema", "database"))
+	r.Equal("other.schema.nested", normalizeTypeName("other.schema.nested", "schema", "database"))
+	r.Equal("numeric(10, 2)", normalizeTypeName("numeric(10, 2)", "schema", "database"))
+}
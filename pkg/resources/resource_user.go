@@ -13,13 +13,16 @@ import (
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
 )
 
 func User() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: userCreate,
 		ReadContext:   userRead,
-		// UpdateContext: userUpdate,
+		UpdateContext: userUpdate,
 		DeleteContext: userDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -42,16 +45,20 @@ func User() *schema.Resource {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Required:    true,
-				ForceNew:    true,
-				Description: "The roles to assign to the user. Allowed values are 'Member' and 'Admin'.",
+				Description: "The roles to assign to the user. Allowed values are 'Member', 'Admin' and the names of custom organization roles.",
 			},
 			"verified": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"metadata": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsJSON,
+				// Frontegg does not keep the formatting of the JSON document
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "The account metadata of the user, as a JSON encoded string.",
 			},
 		},
 	}
@@ -59,18 +66,30 @@ func User() *schema.Resource {
 
 // CreateUserRequest is used to serialize the request body for creating a new user.
 type CreateUserRequest struct {
-	Email   string   `json:"email"`
+	Email    string   `json:"email"`
+	RoleIDs  []string `json:"roleIds"`
+	Metadata string   `json:"metadata,omitempty"`
+}
+
+// UpdateUserRequest is used to serialize the request body for updating the metadata of a user.
+type UpdateUserRequest struct {
+	Metadata string `json:"metadata"`
+}
+
+// RoleIDsRequest is used to serialize the request body for assigning roles to or removing roles from a user or group.
+type RoleIDsRequest struct {
 	RoleIDs []string `json:"roleIds"`
 }
 
 // CreatedUser represents the expected structure of a user creation response.
 type CreatedUser struct {
-	ID                string `json:"id"`
-	Email             string `json:"email"`
-	ProfilePictureURL string `json:"profilePictureUrl"`
-	Verified          bool   `json:"verified"`
-	Metadata          string `json:"metadata"`
-	Provider          string `json:"provider"`
+	ID                string         `json:"id"`
+	Email             string         `json:"email"`
+	ProfilePictureURL string         `json:"profilePictureUrl"`
+	Verified          bool           `json:"verified"`
+	Metadata          string         `json:"metadata"`
+	Provider          string         `json:"provider"`
+	Roles             []FronteggRole `json:"roles"`
 }
 
 type FronteggRolesResponse struct {
@@ -97,31 +116,21 @@ func userCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	email := d.Get("email").(string)
 	roleNames := convertToStringSlice(d.Get("roles").([]interface{}))
 
-	for _, roleName := range roleNames {
-		if roleName != "Member" && roleName != "Admin" {
-			return diag.Errorf("invalid role: %s. Roles must be either 'Member' or 'Admin'", roleName)
-		}
-	}
-
 	// Fetch role IDs based on role names.
 	roleMap, err := listRoles(ctx, client)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error fetching roles: %s", err))
 	}
 
-	var roleIDs []string
-	for _, roleName := range roleNames {
-		if roleID, ok := roleMap[roleName]; ok {
-			roleIDs = append(roleIDs, roleID)
-		} else {
-			// Consider failing the process if the role is not found
-			return diag.Errorf("role not found: %s", roleName)
-		}
+	roleIDs, err := getRoleIDs(roleMap, roleNames)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	createUserRequest := CreateUserRequest{
-		Email:   email,
-		RoleIDs: roleIDs,
+		Email:    email,
+		RoleIDs:  roleIDs,
+		Metadata: d.Get("metadata").(string),
 	}
 
 	requestBody, err := json.Marshal(createUserRequest)
@@ -135,7 +144,6 @@ func userCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	}
 
 	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", "Bearer "+client.Token)
 
 	resp, err := client.HTTPClient.Do(req)
 	if err != nil {
@@ -173,7 +181,6 @@ func userRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating request: %s", err))
 	}
-	req.Header.Add("Authorization", "Bearer "+client.Token)
 
 	// Send the request to the Frontegg API
 	resp, err := client.HTTPClient.Do(req)
@@ -204,10 +211,67 @@ func userRead(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 	d.Set("metadata", user.Metadata)
 	d.Set("auth_provider", user.Provider)
 
+	configured := convertToStringSlice(d.Get("roles").([]interface{}))
+	if err := d.Set("roles", getRoleNames(user.Roles, configured)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
-// TODO: Add userUpdate function to change user roles
+// userUpdate is the Terraform resource update function for a Frontegg user.
+// Roles are changed in place so the user keeps their app passwords.
+func userUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta, err := utils.GetProviderMeta(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := providerMeta.Frontegg
+	userID := d.Id()
+
+	if d.HasChange("roles") {
+		roleMap, err := listRoles(ctx, client)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error fetching roles: %s", err))
+		}
+
+		o, n := d.GetChange("roles")
+		newRoleIDs, err := getRoleIDs(roleMap, convertToStringSlice(n.([]interface{})))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Roles that were removed from the organization cannot be removed from the user
+		var oldRoleIDs []string
+		for _, roleName := range convertToStringSlice(o.([]interface{})) {
+			if roleID, ok := roleMap[roleName]; ok {
+				oldRoleIDs = append(oldRoleIDs, roleID)
+			}
+		}
+
+		// Assign the new roles first so the user always keeps a role
+		if add := diffRoleIDs(newRoleIDs, oldRoleIDs); len(add) > 0 {
+			if err := updateUserRoles(ctx, client, userID, http.MethodPost, add); err != nil {
+				return diag.FromErr(fmt.Errorf("error assigning roles to user: %s", err))
+			}
+		}
+
+		if remove := diffRoleIDs(oldRoleIDs, newRoleIDs); len(remove) > 0 {
+			if err := updateUserRoles(ctx, client, userID, http.MethodDelete, remove); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing roles from user: %s", err))
+			}
+		}
+	}
+
+	if d.HasChange("metadata") {
+		if err := updateUserMetadata(ctx, client, userID, d.Get("metadata").(string)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating user metadata: %s", err))
+		}
+	}
+
+	return userRead(ctx, d, meta)
+}
 
 // userDelete is the Terraform resource delete function for a Frontegg user.
 func userDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -224,7 +288,6 @@ func userDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating request to delete user: %s", err))
 	}
-	req.Header.Add("Authorization", "Bearer "+client.Token)
 
 	// Perform the request
 	resp, err := client.HTTPClient.Do(req)
@@ -260,7 +323,6 @@ func listRoles(ctx context.Context, client *clients.FronteggClient) (map[string]
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %v", err)
 	}
-	req.Header.Add("Authorization", "Bearer "+client.Token)
 
 	resp, err := client.HTTPClient.Do(req)
 	if err != nil {
@@ -289,12 +351,111 @@ func listRoles(ctx context.Context, client *clients.FronteggClient) (map[string]
 	roleMap := make(map[string]string)
 	for _, role := range rolesResponse.Items {
 		log.Printf("[DEBUG] Role found: %s - %s\n", role.Name, role.ID)
-		if role.Name == "Organization Admin" {
-			roleMap["Admin"] = role.ID
-		} else if role.Name == "Organization Member" {
-			roleMap["Member"] = role.ID
-		}
+		roleMap[role.Name] = role.ID
+		roleMap[roleAlias(role.Name)] = role.ID
 	}
 
 	return roleMap, nil
 }
+
+// roleAlias returns the short name of the built in organization roles.
+func roleAlias(roleName string) string {
+	switch roleName {
+	case "Organization Admin":
+		return "Admin"
+	case "Organization Member":
+		return "Member"
+	default:
+		return roleName
+	}
+}
+
+// getRoleIDs resolves role names to their IDs.
+func getRoleIDs(roleMap map[string]string, roleNames []string) ([]string, error) {
+	var roleIDs []string
+	for _, roleName := range roleNames {
+		roleID, ok := roleMap[roleName]
+		if !ok {
+			return nil, fmt.Errorf("role not found: %s", roleName)
+		}
+		roleIDs = append(roleIDs, roleID)
+	}
+	return roleIDs, nil
+}
+
+// diffRoleIDs returns the role IDs in a that are not in b.
+func diffRoleIDs(a, b []string) []string {
+	var diff []string
+	for _, roleID := range a {
+		if !slices.Contains(b, roleID) {
+			diff = append(diff, roleID)
+		}
+	}
+	return diff
+}
+
+// orderRoleNames orders the roles of a user like the configured roles, so
+// that a different order returned by the API is not reported as drift. Roles
+// that are not configured are appended in alphabetical order.
+func orderRoleNames(roleNames, configured []string) []string {
+	remaining := slices.Clone(roleNames)
+	ordered := []string{}
+	for _, c := range configured {
+		if i := slices.Index(remaining, roleAlias(c)); i >= 0 {
+			ordered = append(ordered, c)
+			remaining = slices.Delete(remaining, i, i+1)
+		}
+	}
+	slices.Sort(remaining)
+	return append(ordered, remaining...)
+}
+
+// updateUserRoles assigns roles to a user with a POST request or removes them with a DELETE request.
+func updateUserRoles(ctx context.Context, client *clients.FronteggClient, userID, method string, roleIDs []string) error {
+	requestBody, err := json.Marshal(RoleIDsRequest{RoleIDs: roleIDs})
+	if err != nil {
+		return fmt.Errorf("error marshaling roles request: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/identity/resources/users/v1/%s/roles", client.Endpoint, userID), bytes.NewBuffer(requestBody))
+	if err != nil {
+		return fmt.Errorf("error creating request: %s", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// updateUserMetadata replaces the account metadata of a user.
+func updateUserMetadata(ctx context.Context, client *clients.FronteggClient, userID, metadata string) error {
+	requestBody, err := json.Marshal(UpdateUserRequest{Metadata: metadata})
+	if err != nil {
+		return fmt.Errorf("error marshaling update user request: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/identity/resources/users/v1/%s", client.Endpoint, userID), bytes.NewBuffer(requestBody))
+	if err != nil {
+		return fmt.Errorf("error creating request: %s", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/exp/slices"
+)
+
+var userDatabaseRoleSchema = map[string]*schema.Schema{
+	"email": {
+		Description: "The email address of the user. The database role of a user is named after their email address.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"roles": {
+		Description: "The database roles to grant to the user.",
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+	},
+	"qualified_sql_name": QualifiedNameSchema("role"),
+	"region":             RegionSchema(),
+}
+
+func UserDatabaseRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "The database role of a user in a region. The role is created before the first login of the user, so roles can be granted to it without waiting for the user to log in. The role is dropped on delete.",
+
+		CreateContext: userDatabaseRoleCreate,
+		ReadContext:   userDatabaseRoleRead,
+		UpdateContext: userDatabaseRoleUpdate,
+		DeleteContext: userDatabaseRoleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: userDatabaseRoleSchema,
+	}
+}
+
+func userDatabaseRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	i := d.Id()
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := materialize.ScanRole(metaDb, utils.ExtractId(i))
+	if err == sql.ErrNoRows {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
+	roles, err := materialize.ListRoleMemberships(metaDb, utils.ExtractId(i))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("email", s.RoleName.String); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("roles", roles); err != nil {
+		return diag.FromErr(err)
+	}
+
+	qn := materialize.QualifiedName(s.RoleName.String)
+	if err := d.Set("qualified_sql_name", qn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func userDatabaseRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	email := d.Get("email").(string)
+
+	metaDb, region, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	i, err := createUserDatabaseRole(metaDb, email)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(utils.TransformIdWithRegion(string(region), i))
+
+	roles := convertToStringSlice(d.Get("roles").(*schema.Set).List())
+	if err := grantUserDatabaseRoles(metaDb, email, roles, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return userDatabaseRoleRead(ctx, d, meta)
+}
+
+func userDatabaseRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	email := d.Get("email").(string)
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("roles") {
+		o, n := d.GetChange("roles")
+		grant := convertToStringSlice(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+		revoke := convertToStringSlice(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+
+		if err := grantUserDatabaseRoles(metaDb, email, grant, revoke); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return userDatabaseRoleRead(ctx, d, meta)
+}
+
+func userDatabaseRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	email := d.Get("email").(string)
+
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Dropping the role also removes its role memberships
+	o := materialize.MaterializeObject{ObjectType: "ROLE", Name: email}
+	b := materialize.NewRoleBuilder(metaDb, o)
+
+	if err := b.Drop(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// createUserDatabaseRole creates the database role of a user and returns its
+// id. A role that already exists, because the user logged in before, is reused.
+func createUserDatabaseRole(conn *sqlx.DB, email string) (string, error) {
+	i, err := materialize.RoleId(conn, email)
+	if err == nil {
+		log.Printf("[DEBUG] role %s already exists", email)
+		return i, nil
+	} else if err != sql.ErrNoRows {
+		return "", err
+	}
+
+	o := materialize.MaterializeObject{ObjectType: "ROLE", Name: email}
+	createErr := materialize.NewRoleBuilder(conn, o).Create()
+
+	// The user can log in, and create the role, at the same time
+	i, err = materialize.RoleId(conn, email)
+	if err == sql.ErrNoRows && createErr != nil {
+		return "", createErr
+	} else if err != nil {
+		return "", err
+	}
+
+	return i, nil
+}
+
+// grantUserDatabaseRoles grants and revokes database roles of a user.
+func grantUserDatabaseRoles(conn *sqlx.DB, email string, grant, revoke []string) error {
+	slices.Sort(grant)
+	slices.Sort(revoke)
+
+	for _, role := range grant {
+		b := materialize.NewRolePrivilegeBuilder(conn, role, email)
+		if err := b.Grant(); err != nil {
+			return fmt.Errorf("error granting role %s: %s", role, err)
+		}
+	}
+
+	for _, role := range revoke {
+		b := materialize.NewRolePrivilegeBuilder(conn, role, email)
+		if err := b.Revoke(); err != nil {
+			return fmt.Errorf("error revoking role %s: %s", role, err)
+		}
+	}
+
+	return nil
+}
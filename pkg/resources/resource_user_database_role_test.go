@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/testhelpers"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var inUserDatabaseRole = map[string]interface{}{
+	"email": "joe",
+	"roles": []interface{}{"analyst", "dev_role"},
+}
+
+func TestResourceUserDatabaseRoleCreate(t *testing.T) {
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, UserDatabaseRole().Schema, inUserDatabaseRole)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// Query Id, the role does not exist yet
+		ip := `WHERE mz_roles.name = 'joe'`
+		mock.ExpectQuery(`SELECT .* FROM mz_roles .* WHERE mz_roles.name = 'joe';`).WillReturnRows(
+			mock.NewRows([]string{"id", "role_name", "inherit"}),
+		)
+
+		// Create
+		mock.ExpectExec(`CREATE ROLE "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		testhelpers.MockRoleScan(mock, ip)
+
+		// Grant
+		mock.ExpectExec(`GRANT "analyst" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`GRANT "dev_role" TO "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		// Query Params
+		pp := `WHERE mz_roles.id = 'u1'`
+		testhelpers.MockRoleScan(mock, pp)
+		testhelpers.MockRoleMembershipScan(mock, `WHERE mz_role_members.member = 'u1'`)
+
+		if err := userDatabaseRoleCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u1", d.Id())
+		r.ElementsMatch([]interface{}{"analyst", "dev_role"}, d.Get("roles").(*schema.Set).List())
+	})
+}
+
+func TestResourceUserDatabaseRoleCreateExisting(t *testing.T) {
+	r := require.New(t)
+	in := map[string]interface{}{"email": "joe"}
+	d := schema.TestResourceDataRaw(t, UserDatabaseRole().Schema, in)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		// The user logged in before, the role is not created again
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.name = 'joe'`)
+
+		// Query Params
+		testhelpers.MockRoleScan(mock, `WHERE mz_roles.id = 'u1'`)
+		testhelpers.MockRoleMembershipScan(mock, `WHERE mz_role_members.member = 'u1'`)
+
+		if err := userDatabaseRoleCreate(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("aws/us-east-1:u1", d.Id())
+	})
+}
+
+func TestResourceUserDatabaseRoleDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, UserDatabaseRole().Schema, inUserDatabaseRole)
+	r.NotNil(d)
+
+	testhelpers.WithMockProviderMeta(t, func(db *utils.ProviderMeta, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP ROLE "joe";`).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		if err := userDatabaseRoleDelete(context.TODO(), d, db); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
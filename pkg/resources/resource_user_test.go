@@ -35,9 +35,147 @@ func TestUserResourceRead(t *testing.T) {
 		}
 
 		r.Equal("test@example.com", d.Get("email"))
+		r.Equal([]interface{}{"Admin", "Member"}, d.Get("roles"))
 	})
 }
 
+func TestUserResourceReadConfiguredRoleOrder(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		client := &clients.FronteggClient{
+			Endpoint:    serverURL,
+			HTTPClient:  &http.Client{},
+			TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		providerMeta := &utils.ProviderMeta{
+			Frontegg: client,
+		}
+
+		in := map[string]interface{}{
+			"email": "test@example.com",
+			"roles": []interface{}{"Member", "Organization Admin"},
+		}
+		d := schema.TestResourceDataRaw(t, User().Schema, in)
+		d.SetId("mock-user-id")
+
+		if err := userRead(context.TODO(), d, providerMeta); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal([]interface{}{"Member", "Organization Admin"}, d.Get("roles"))
+	})
+}
+
+func TestUserResourceCreate(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		client := &clients.FronteggClient{
+			Endpoint:    serverURL,
+			HTTPClient:  &http.Client{},
+			TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		providerMeta := &utils.ProviderMeta{
+			Frontegg: client,
+		}
+
+		in := map[string]interface{}{
+			"email": "test@example.com",
+			"roles": []interface{}{"Member", "Billing Manager"},
+		}
+		d := schema.TestResourceDataRaw(t, User().Schema, in)
+
+		if err := userCreate(context.TODO(), d, providerMeta); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("mock-user-id", d.Id())
+	})
+}
+
+func TestUserResourceCreateUnknownRole(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		client := &clients.FronteggClient{
+			Endpoint:    serverURL,
+			HTTPClient:  &http.Client{},
+			TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		providerMeta := &utils.ProviderMeta{
+			Frontegg: client,
+		}
+
+		in := map[string]interface{}{
+			"email": "test@example.com",
+			"roles": []interface{}{"Owner"},
+		}
+		d := schema.TestResourceDataRaw(t, User().Schema, in)
+
+		diags := userCreate(context.TODO(), d, providerMeta)
+		r.True(diags.HasError())
+		r.Equal("role not found: Owner", diags[0].Summary)
+	})
+}
+
+func TestUserResourceUpdate(t *testing.T) {
+	r := require.New(t)
+
+	testhelpers.WithMockFronteggServer(t, func(serverURL string) {
+		client := &clients.FronteggClient{
+			Endpoint:    serverURL,
+			HTTPClient:  &http.Client{},
+			TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		providerMeta := &utils.ProviderMeta{
+			Frontegg: client,
+		}
+
+		in := map[string]interface{}{
+			"email":    "test@example.com",
+			"roles":    []interface{}{"Admin", "Member"},
+			"metadata": `{"team": "data"}`,
+		}
+		d := schema.TestResourceDataRaw(t, User().Schema, in)
+		d.SetId("mock-user-id")
+
+		if err := userUpdate(context.TODO(), d, providerMeta); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Equal("mock-user-id", d.Id())
+		r.Equal([]interface{}{"Admin", "Member"}, d.Get("roles"))
+	})
+}
+
+func TestOrderRoleNames(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal([]string{"Member", "Admin"}, orderRoleNames([]string{"Admin", "Member"}, []string{"Member", "Admin"}))
+	r.Equal([]string{"Organization Admin", "Billing Manager", "Member"}, orderRoleNames([]string{"Member", "Billing Manager", "Admin"}, []string{"Organization Admin"}))
+	r.Equal([]string{}, orderRoleNames(nil, []string{"Admin"}))
+}
+
+func TestUserMetadataDiffSuppress(t *testing.T) {
+	r := require.New(t)
+	suppress := User().Schema["metadata"].DiffSuppressFunc
+
+	r.True(suppress("metadata", `{"team":"data","level":1}`, `{ "level": 1, "team": "data" }`, nil))
+	r.False(suppress("metadata", `{"team":"data"}`, `{"team":"platform"}`, nil))
+}
+
+func TestDiffRoleIDs(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal([]string{"3"}, diffRoleIDs([]string{"1", "3"}, []string{"1", "2"}))
+	r.Nil(diffRoleIDs([]string{"1"}, []string{"1", "2"}))
+}
+
 func TestUserResourceDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -43,7 +43,7 @@ func View() *schema.Resource {
 		DeleteContext: viewDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importFromCreateSql(viewRead, materialize.View, viewImport),
 		},
 
 		Schema: viewSchema,
@@ -211,3 +211,12 @@ func viewDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 	}
 	return nil
 }
+
+func viewImport(d *schema.ResourceData, createSql string) error {
+	v, err := materialize.ParseView(createSql)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("statement", v.Statement)
+}
@@ -70,14 +70,16 @@ func QualifiedNameSchema(resource string) *schema.Schema {
 	}
 }
 
+// SizeSchema is validated at plan time by validateReplicaSize against the
+// sizes available in the region.
 func SizeSchema(resource string, required bool, forceNew bool) *schema.Schema {
 	return &schema.Schema{
 		Type:         schema.TypeString,
-		Description:  fmt.Sprintf("The size of the %s.", resource),
+		Description:  fmt.Sprintf("The size of the %s. The sizes available in the region can be listed with the `materialize_cluster_replica_sizes` data source.", resource),
 		Required:     required,
 		Optional:     !required,
 		ForceNew:     forceNew,
-		ValidateFunc: validation.StringInSlice(replicaSizes, true),
+		ValidateFunc: validation.StringIsNotWhiteSpace,
 	}
 }
 
@@ -123,6 +125,14 @@ func IdentifierSchema(elem, description string, required bool) *schema.Schema {
 	}
 }
 
+// ExposeProgressSchema is the progress subsource of a source. Materialize
+// names the subsource when it is not configured so the value is computed.
+func ExposeProgressSchema() *schema.Schema {
+	s := IdentifierSchema("expose_progress", "The name of the progress subsource for the source. If this is not specified, the subsource will be named `<src_name>_progress`.", false)
+	s.Computed = true
+	return s
+}
+
 func ValueSecretSchema(elem string, description string, required bool) *schema.Schema {
 	return &schema.Schema{
 		Type: schema.TypeList,
@@ -365,6 +375,88 @@ func SinkFormatSpecSchema(elem string, description string, required bool) *schem
 	}
 }
 
+func SourceEnvelopeSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "How Materialize should interpret records (e.g. append-only, upsert)..",
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"upsert": {
+					Description:   "Use the upsert envelope, which uses message keys to handle CRUD operations.",
+					Type:          schema.TypeBool,
+					Optional:      true,
+					ForceNew:      true,
+					ConflictsWith: []string{"envelope.0.debezium", "envelope.0.none"},
+				},
+				"upsert_options": {
+					Description: "Options for the upsert envelope.",
+					Type:        schema.TypeList,
+					MaxItems:    1,
+					Optional:    true,
+					ForceNew:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"value_decoding_errors": {
+								Description: "Specify how to handle value decoding errors in the upsert envelope.",
+								Type:        schema.TypeList,
+								MaxItems:    1,
+								Optional:    true,
+								ForceNew:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"inline": {
+											Description: "Configuration for inline value decoding errors. Instead of blocking the source, decoding errors are reported in an additional column.",
+											Type:        schema.TypeList,
+											MaxItems:    1,
+											Optional:    true,
+											ForceNew:    true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"enabled": {
+														Description: "Enable inline value decoding errors.",
+														Type:        schema.TypeBool,
+														Optional:    true,
+														ForceNew:    true,
+														Default:     false,
+													},
+													"alias": {
+														Description: "Specify an alias for the value decoding errors column. Defaults to `error`.",
+														Type:        schema.TypeString,
+														Optional:    true,
+														ForceNew:    true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"debezium": {
+					Description:   "Use the Debezium envelope, which uses a diff envelope to handle CRUD operations.",
+					Type:          schema.TypeBool,
+					Optional:      true,
+					ForceNew:      true,
+					ConflictsWith: []string{"envelope.0.upsert", "envelope.0.none"},
+				},
+				"none": {
+					Description:   "Use an append-only envelope. This means that records will only be appended and cannot be updated or deleted.",
+					Type:          schema.TypeBool,
+					Optional:      true,
+					ForceNew:      true,
+					ConflictsWith: []string{"envelope.0.upsert", "envelope.0.debezium"},
+				},
+			},
+		},
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+}
+
 func SubsourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Description: "Subsources of a source.",
@@ -3,6 +3,7 @@ package resources
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,3 +37,19 @@ func validPrivileges(objType string) schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+func validDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a duration such as '720h', got '%s'", k, v))
+	} else if d <= 0 {
+		errors = append(errors, fmt.Errorf("expected %s to be a positive duration, got '%s'", k, v))
+	}
+	return warnings, errors
+}
@@ -47,3 +47,23 @@ func TestValidPrivileges(t *testing.T) {
 		},
 	})
 }
+
+func TestValidDuration(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val:  "720h",
+			f:    validDuration,
+			pass: true,
+		},
+		{
+			val:  "30d",
+			f:    validDuration,
+			pass: false,
+		},
+		{
+			val:  "-1h",
+			f:    validDuration,
+			pass: false,
+		},
+	})
+}
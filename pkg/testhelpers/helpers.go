@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +27,29 @@ type MockAppPassword struct {
 	Secret      string    `json:"secret"`
 }
 
+type MockServicePassword struct {
+	ClientID    string            `json:"clientId"`
+	Description string            `json:"description"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	Secret      string            `json:"secret"`
+	Metadata    map[string]string `json:"metadata"`
+	RoleIDs     []string          `json:"roleIds"`
+}
+
+type MockRole struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type MockUser struct {
+	ID                string     `json:"id"`
+	Email             string     `json:"email"`
+	ProfilePictureURL string     `json:"profilePictureUrl"`
+	Verified          bool       `json:"verified"`
+	Metadata          string     `json:"metadata"`
+	Roles             []MockRole `json:"roles"`
+}
+
 func WithMockDb(t *testing.T, f func(*sqlx.DB, sqlmock.Sqlmock)) {
 	// Set the region for testing
 	utils.DefaultRegion = "aws/us-east-1"
@@ -73,6 +97,7 @@ func WithMockProviderMeta(t *testing.T, f func(*utils.ProviderMeta, sqlmock.Sqlm
 func WithMockFronteggServer(t *testing.T, f func(url string)) {
 	t.Helper()
 	r := require.New(t)
+	identity := newMockFronteggIdentity()
 
 	// Create a mock HTTP server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -114,23 +139,28 @@ func WithMockFronteggServer(t *testing.T, f func(url string)) {
 			switch req.Method {
 			case http.MethodGet:
 				if strings.HasSuffix(req.URL.Path, "/mock-user-id") {
-					mockUser := struct {
-						ID                string `json:"id"`
-						Email             string `json:"email"`
-						ProfilePictureURL string `json:"profilePictureUrl"`
-						Verified          bool   `json:"verified"`
-						Metadata          string `json:"metadata"`
-					}{
+					mockUser := MockUser{
 						ID:                "mock-user-id",
 						Email:             "test@example.com",
 						ProfilePictureURL: "http://example.com/picture.jpg",
 						Verified:          true,
 						Metadata:          "{}",
+						Roles: []MockRole{
+							{ID: "2", Name: "Organization Member"},
+							{ID: "1", Name: "Organization Admin"},
+						},
 					}
 					json.NewEncoder(w).Encode(mockUser)
 				} else {
 					w.WriteHeader(http.StatusNotFound)
 				}
+			case http.MethodPut:
+				var updateReq struct {
+					Metadata string `json:"metadata"`
+				}
+				err := json.NewDecoder(req.Body).Decode(&updateReq)
+				r.NoError(err)
+				w.WriteHeader(http.StatusOK)
 			case http.MethodDelete:
 				if strings.HasSuffix(req.URL.Path, "/mock-user-id") {
 					w.WriteHeader(http.StatusOK)
@@ -138,9 +168,73 @@ func WithMockFronteggServer(t *testing.T, f func(url string)) {
 					w.WriteHeader(http.StatusNotFound)
 				}
 			}
+		case "/identity/resources/users/v1/mock-user-id/roles":
+			switch req.Method {
+			case http.MethodPost, http.MethodDelete:
+				var rolesReq struct {
+					RoleIDs []string `json:"roleIds"`
+				}
+				err := json.NewDecoder(req.Body).Decode(&rolesReq)
+				r.NoError(err)
+				r.NotEmpty(rolesReq.RoleIDs)
+				w.WriteHeader(http.StatusOK)
+			default:
+				http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			}
+		case "/identity/resources/users/v2":
+			var createReq struct {
+				Email   string   `json:"email"`
+				RoleIDs []string `json:"roleIds"`
+			}
+			err := json.NewDecoder(req.Body).Decode(&createReq)
+			r.NoError(err)
+
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(MockUser{
+				ID:       "mock-user-id",
+				Email:    createReq.Email,
+				Metadata: "{}",
+			})
+		case "/identity/resources/tenants/api-tokens/v1":
+			switch req.Method {
+			case http.MethodPost:
+				var createReq MockServicePassword
+				err := json.NewDecoder(req.Body).Decode(&createReq)
+				r.NoError(err)
+
+				createReq.ClientID = "mock-service-client-id"
+				createReq.CreatedAt = time.Now()
+				createReq.Secret = "mock-service-secret"
 
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(createReq)
+			case http.MethodGet:
+				servicePassword := MockServicePassword{
+					ClientID:    "mock-service-client-id",
+					Description: "test-service-password",
+					CreatedAt:   time.Now(),
+					Secret:      "mock-service-secret",
+					Metadata:    map[string]string{"user": "ci"},
+					RoleIDs:     []string{"2", "3"},
+				}
+				json.NewEncoder(w).Encode([]MockServicePassword{servicePassword})
+			default:
+				http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			}
+		case "/identity/resources/tenants/api-tokens/v1/mock-service-client-id":
+			if req.Method != http.MethodDelete {
+				http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/identity/resources/roles/v2":
+			json.NewEncoder(w).Encode(struct {
+				Items []MockRole `json:"items"`
+			}{
+				Items: mockRoles,
+			})
 		default:
-			http.Error(w, "Not Found", http.StatusNotFound)
+			identity.ServeHTTP(w, req)
 		}
 	}))
 	defer server.Close()
@@ -224,3 +318,60 @@ func copyHeaders(dst, src http.Header) {
 		}
 	}
 }
+
+// WithMockCloudRegionServer sets up a mock HTTP server with a single region,
+// aws/us-east-1, that starts disabled. Enabling the region makes it
+// resolvable on the second read, disabling it removes its region info.
+func WithMockCloudRegionServer(t *testing.T, f func(url string)) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var regionInfo *clients.RegionInfo
+	reads := 0
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch req.URL.Path {
+		case "/api/cloud-regions":
+			json.NewEncoder(w).Encode(clients.CloudProviderResponse{
+				Data: []clients.CloudProvider{
+					{ID: "aws/us-east-1", Name: "us-east-1", Url: server.URL, CloudProvider: "aws"},
+				},
+			})
+		case "/api/region":
+			switch req.Method {
+			case http.MethodGet:
+				if regionInfo == nil {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				reads++
+				regionInfo.Resolvable = reads > 1
+				json.NewEncoder(w).Encode(clients.CloudRegion{RegionInfo: regionInfo})
+			case http.MethodPatch:
+				if regionInfo == nil {
+					regionInfo = &clients.RegionInfo{
+						SqlAddress:  "sql.materialize.com",
+						HttpAddress: "http.materialize.com",
+						EnabledAt:   "2021-01-01T00:00:00Z",
+					}
+					reads = 0
+				}
+				json.NewEncoder(w).Encode(clients.CloudRegion{RegionInfo: regionInfo})
+			case http.MethodDelete:
+				regionInfo = nil
+				w.WriteHeader(http.StatusAccepted)
+			default:
+				http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			}
+		default:
+			http.Error(w, "Not Found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	f(server.URL)
+}
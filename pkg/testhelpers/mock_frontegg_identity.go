@@ -0,0 +1,293 @@
+package testhelpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+type MockSSODomain struct {
+	ID          string `json:"id"`
+	Domain      string `json:"domain"`
+	Validated   bool   `json:"validated"`
+	SsoConfigId string `json:"ssoConfigId"`
+	TxtRecord   string `json:"txtRecord"`
+}
+
+type MockSSOConfig struct {
+	ID                string          `json:"id"`
+	Enabled           bool            `json:"enabled"`
+	SsoEndpoint       string          `json:"ssoEndpoint"`
+	PublicCertificate string          `json:"publicCertificate"`
+	SignRequest       bool            `json:"signRequest"`
+	AcsUrl            string          `json:"acsUrl"`
+	SpEntityId        string          `json:"spEntityId"`
+	Type              string          `json:"type"`
+	OidcClientId      string          `json:"oidcClientId"`
+	OidcSecret        string          `json:"oidcSecret"`
+	Domains           []MockSSODomain `json:"domains"`
+}
+
+type MockGroup struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Roles       []MockRole `json:"roles"`
+}
+
+type MockSCIMConfig struct {
+	ID             string `json:"id"`
+	Source         string `json:"source"`
+	TenantID       string `json:"tenantId"`
+	ConnectionName string `json:"connectionName"`
+	CreatedAt      string `json:"createdAt"`
+	Token          string `json:"token,omitempty"`
+}
+
+// mockRoles are the roles of the organization returned by the mock Frontegg server.
+var mockRoles = []MockRole{
+	{ID: "1", Name: "Organization Admin"},
+	{ID: "2", Name: "Organization Member"},
+	{ID: "3", Name: "Billing Manager"},
+}
+
+// mockFronteggIdentity keeps the SSO configurations, groups and SCIM
+// configurations created against the mock Frontegg server.
+type mockFronteggIdentity struct {
+	mu          sync.Mutex
+	nextID      int
+	ssoConfigs  []*MockSSOConfig
+	groups      map[string]*MockGroup
+	scimConfigs []MockSCIMConfig
+}
+
+func newMockFronteggIdentity() *mockFronteggIdentity {
+	return &mockFronteggIdentity{groups: map[string]*MockGroup{}}
+}
+
+func (m *mockFronteggIdentity) id(prefix string) string {
+	m.nextID++
+	return fmt.Sprintf("%s-%d", prefix, m.nextID)
+}
+
+func (m *mockFronteggIdentity) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(req.URL.Path, "/frontegg/team/resources/sso/v1/configurations"):
+		m.handleSSO(w, req, strings.TrimPrefix(req.URL.Path, "/frontegg/team/resources/sso/v1/configurations"))
+	case strings.HasPrefix(req.URL.Path, "/frontegg/identity/resources/groups/v1"):
+		m.handleGroups(w, req, strings.TrimPrefix(req.URL.Path, "/frontegg/identity/resources/groups/v1"))
+	case strings.HasPrefix(req.URL.Path, "/frontegg/directory/resources/v1/configurations/scim2"):
+		m.handleSCIM(w, req, strings.TrimPrefix(req.URL.Path, "/frontegg/directory/resources/v1/configurations/scim2"))
+	default:
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}
+}
+
+func (m *mockFronteggIdentity) handleSSO(w http.ResponseWriter, req *http.Request, path string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case path == "" && req.Method == http.MethodGet:
+		json.NewEncoder(w).Encode(m.ssoConfigs)
+	case path == "" && req.Method == http.MethodPost:
+		var config MockSSOConfig
+		json.NewDecoder(req.Body).Decode(&config)
+		config.ID = m.id("sso")
+		config.AcsUrl = "https://auth.materialize.com/auth/saml/callback"
+		config.SpEntityId = "https://auth.materialize.com/" + config.ID
+		config.OidcSecret = ""
+		m.ssoConfigs = append(m.ssoConfigs, &config)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(config)
+	case len(parts) == 1:
+		i := m.ssoConfigIndex(parts[0])
+		if i < 0 {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		switch req.Method {
+		case http.MethodPatch:
+			config := m.ssoConfigs[i]
+			var update MockSSOConfig
+			json.NewDecoder(req.Body).Decode(&update)
+			config.Enabled = update.Enabled
+			config.SsoEndpoint = update.SsoEndpoint
+			config.PublicCertificate = update.PublicCertificate
+			config.SignRequest = update.SignRequest
+			config.OidcClientId = update.OidcClientId
+			json.NewEncoder(w).Encode(config)
+		case http.MethodDelete:
+			m.ssoConfigs = append(m.ssoConfigs[:i], m.ssoConfigs[i+1:]...)
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		}
+	case len(parts) >= 2 && parts[1] == "domains":
+		i := m.ssoConfigIndex(parts[0])
+		if i < 0 {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		config := m.ssoConfigs[i]
+		switch {
+		case len(parts) == 2 && req.Method == http.MethodPost:
+			var domain MockSSODomain
+			json.NewDecoder(req.Body).Decode(&domain)
+			domain.ID = m.id("domain")
+			domain.SsoConfigId = config.ID
+			domain.TxtRecord = "_saml-domain-challenge." + domain.ID
+			config.Domains = append(config.Domains, domain)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(domain)
+		case len(parts) == 3 && req.Method == http.MethodDelete:
+			for j, domain := range config.Domains {
+				if domain.ID == parts[2] {
+					config.Domains = append(config.Domains[:j], config.Domains[j+1:]...)
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+			}
+			http.Error(w, "Not Found", http.StatusNotFound)
+		case len(parts) == 4 && parts[3] == "validate" && req.Method == http.MethodPut:
+			for j := range config.Domains {
+				if config.Domains[j].ID == parts[2] {
+					// Domains under example.com never have the TXT record
+					if strings.HasSuffix(config.Domains[j].Domain, "example.com") {
+						http.Error(w, "TXT record not found", http.StatusBadRequest)
+						return
+					}
+					config.Domains[j].Validated = true
+					json.NewEncoder(w).Encode(config.Domains[j])
+					return
+				}
+			}
+			http.Error(w, "Not Found", http.StatusNotFound)
+		default:
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		}
+	default:
+		http.Error(w, "Not Found", http.StatusNotFound)
+	}
+}
+
+func (m *mockFronteggIdentity) ssoConfigIndex(id string) int {
+	for i, c := range m.ssoConfigs {
+		if c.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *mockFronteggIdentity) handleGroups(w http.ResponseWriter, req *http.Request, path string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	if path == "" {
+		if req.Method != http.MethodPost {
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var group MockGroup
+		json.NewDecoder(req.Body).Decode(&group)
+		group.ID = m.id("group")
+		m.groups[group.ID] = &group
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(group)
+		return
+	}
+
+	group, ok := m.groups[parts[0]]
+	if !ok {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
+	if len(parts) == 2 && parts[1] == "roles" {
+		var rolesReq struct {
+			RoleIDs []string `json:"roleIds"`
+		}
+		json.NewDecoder(req.Body).Decode(&rolesReq)
+		switch req.Method {
+		case http.MethodPost:
+			for _, role := range mockRoles {
+				if contains(rolesReq.RoleIDs, role.ID) {
+					group.Roles = append(group.Roles, role)
+				}
+			}
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			var kept []MockRole
+			for _, role := range group.Roles {
+				if !contains(rolesReq.RoleIDs, role.ID) {
+					kept = append(kept, role)
+				}
+			}
+			group.Roles = kept
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(group)
+	case http.MethodPatch:
+		var update MockGroup
+		json.NewDecoder(req.Body).Decode(&update)
+		group.Name = update.Name
+		group.Description = update.Description
+		json.NewEncoder(w).Encode(group)
+	case http.MethodDelete:
+		delete(m.groups, group.ID)
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (m *mockFronteggIdentity) handleSCIM(w http.ResponseWriter, req *http.Request, path string) {
+	id := strings.Trim(path, "/")
+
+	switch {
+	case id == "" && req.Method == http.MethodGet:
+		json.NewEncoder(w).Encode(m.scimConfigs)
+	case id == "" && req.Method == http.MethodPost:
+		var config MockSCIMConfig
+		json.NewDecoder(req.Body).Decode(&config)
+		config.ID = m.id("scim")
+		config.TenantID = "mock-tenant-id"
+		config.CreatedAt = "2024-01-01T00:00:00Z"
+		m.scimConfigs = append(m.scimConfigs, config)
+		// The token is only returned on create
+		config.Token = "mock-scim-token"
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(config)
+	case id != "" && req.Method == http.MethodDelete:
+		for i, c := range m.scimConfigs {
+			if c.ID == id {
+				m.scimConfigs = append(m.scimConfigs[:i], m.scimConfigs[i+1:]...)
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+		}
+		http.Error(w, "Not Found", http.StatusNotFound)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -27,6 +27,12 @@ func mockQueryBuilder(query, predicate, order string) string {
 	return q.String()
 }
 
+func MockShowCreate(mock sqlmock.Sqlmock, object, createSql string) {
+	q := fmt.Sprintf(`SHOW CREATE %s;`, object)
+	ir := mock.NewRows([]string{"name", "create_sql"}).AddRow("", createSql)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockClusterReplicaScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -53,6 +59,61 @@ func MockClusterReplicaScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockClusterReplicaUtilizationScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_cluster_replicas.id,
+		utilization.cpu_percent,
+		utilization.memory_percent,
+		utilization.disk_percent,
+		statuses.status,
+		statuses.reason
+	FROM mz_cluster_replicas
+	LEFT JOIN \(
+		SELECT
+			replica_id,
+			max\(cpu_percent\) AS cpu_percent,
+			max\(memory_percent\) AS memory_percent,
+			max\(disk_percent\) AS disk_percent
+		FROM mz_internal.mz_cluster_replica_utilization
+		GROUP BY replica_id
+	\) utilization
+		ON mz_cluster_replicas.id = utilization.replica_id
+	LEFT JOIN \(
+		SELECT
+			replica_id,
+			CASE WHEN bool_and\(status = 'ready'\) THEN 'ready' ELSE 'not-ready' END AS status,
+			max\(reason\) AS reason
+		FROM mz_internal.mz_cluster_replica_statuses
+		GROUP BY replica_id
+	\) statuses
+		ON mz_cluster_replicas.id = statuses.replica_id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "cpu_percent", "memory_percent", "disk_percent", "status", "reason"}).
+		AddRow("u1", 12.5, 97.25, 3.0, "not-ready", "oom-killed")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockClusterReplicaSizeScan(mock sqlmock.Sqlmock) {
+	b := `
+	SELECT
+		mz_cluster_replica_sizes.size,
+		mz_cluster_replica_sizes.processes::int8 AS processes,
+		mz_cluster_replica_sizes.workers::int8 AS workers,
+		mz_cluster_replica_sizes.cpu_nano_cores::int8 AS cpu_nano_cores,
+		mz_cluster_replica_sizes.memory_bytes::int8 AS memory_bytes,
+		mz_cluster_replica_sizes.disk_bytes::int8 AS disk_bytes,
+		mz_cluster_replica_sizes.credits_per_hour::float8 AS credits_per_hour
+	FROM mz_internal.mz_cluster_replica_sizes`
+
+	q := mockQueryBuilder(b, "", "ORDER BY credits_per_hour, size")
+	ir := mock.NewRows([]string{"size", "processes", "workers", "cpu_nano_cores", "memory_bytes", "disk_bytes", "credits_per_hour"}).
+		AddRow("25cc", 1, 1, 500000000, 3758096384, 7516192768, 0.25).
+		AddRow("100cc", 1, 2, 2000000000, 15032385536, 30064771072, 1)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockClusterScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -81,6 +142,39 @@ func MockClusterScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockCommentScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_comments.id,
+		mz_comments.object_type,
+		mz_comments.object_sub_id,
+		mz_comments.comment
+	FROM mz_internal.mz_comments`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "object_type", "object_sub_id", "comment"}).
+		AddRow("u1", "cluster", nil, "comment")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockColumnCommentScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_comments.id,
+		mz_comments.object_type,
+		mz_comments.object_sub_id,
+		mz_comments.comment
+	FROM mz_internal.mz_comments
+	JOIN mz_columns
+		ON mz_comments.id = mz_columns.id
+		AND mz_comments.object_sub_id = mz_columns.position`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "object_type", "object_sub_id", "comment"}).
+		AddRow("u1", "view", 1, "column comment")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockConnectionScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -112,6 +206,45 @@ func MockConnectionScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockConnectionAwsScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_connections.id,
+		mz_connections.name AS connection_name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_aws_connections.endpoint,
+		mz_aws_connections.region,
+		mz_aws_connections.access_key_id,
+		mz_aws_connections.assume_role_arn,
+		mz_aws_connections.assume_role_session_name,
+		mz_aws_connections.principal,
+		mz_aws_connections.external_id,
+		mz_aws_connections.example_trust_policy,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name
+	FROM mz_connections
+	JOIN mz_schemas
+		ON mz_connections.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_internal.mz_aws_connections
+		ON mz_connections.id = mz_aws_connections.id
+	JOIN mz_roles
+		ON mz_connections.owner_id = mz_roles.id
+	LEFT JOIN \(
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'connection'
+	\) comments
+		ON mz_connections.id = comments.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "connection_name", "schema_name", "database_name", "region", "assume_role_arn", "assume_role_session_name", "principal", "external_id", "example_trust_policy", "owner_name"}).
+		AddRow("u1", "connection", "schema", "database", "us-east-1", "arn:aws:iam::123456789012:role/Materialize", "materialize", "arn:aws:iam::664411391173:role/MaterializeConnection", "mz_eb5cb59b-e2fe-41f3-87ca-d2176a495345_u1", "{}", "joe")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockConnectionAwsPrivatelinkScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -231,39 +364,39 @@ func MockDatabaseScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
-func MockIndexColumnScan(mock sqlmock.Sqlmock, predicate string) {
+func MockIndexKeyScan(mock sqlmock.Sqlmock, predicate string) {
+	MockIndexKeysScan(mock, predicate, "column", "lower(name)")
+}
+
+// MockIndexKeysScan returns the expressions as the key of an index.
+func MockIndexKeysScan(mock sqlmock.Sqlmock, predicate string, keys ...string) {
 	b := `
 	SELECT
-		mz_columns.id,
-		mz_columns.name,
-		mz_columns.position,
-		mz_columns.nullable,
-		mz_columns.type,
-		mz_columns.default,
-		CASE WHEN mz_index_columns.index_id IS NOT NULL THEN true ELSE false END AS indexed_column,
-		mz_indexes.name AS index_name,
-		mz_indexes.id AS index_id
-	FROM mz_columns
-	LEFT JOIN mz_indexes
-		ON mz_columns.id = mz_indexes.on_id
-	LEFT JOIN mz_index_columns
+		mz_index_columns.index_position,
+		COALESCE\(mz_index_columns.on_expression, mz_columns.name\) AS expression
+	FROM mz_index_columns
+	JOIN mz_indexes
 		ON mz_index_columns.index_id = mz_indexes.id
-		AND mz_index_columns.index_position = mz_columns.position`
+	LEFT JOIN mz_columns
+		ON mz_indexes.on_id = mz_columns.id
+		AND mz_index_columns.on_position = mz_columns.position`
 
-	q := mockQueryBuilder(b, predicate, "ORDER BY mz_columns.position")
-	ir := mock.NewRows([]string{"id", "name", "position", "nullable", "type", "default", "indexed_column", "index_name", "index_id"}).
-		AddRow("u1", "column", "1", "true", "integer", "", "true", "index", "u1")
+	q := mockQueryBuilder(b, predicate, "ORDER BY mz_index_columns.index_position")
+	ir := mock.NewRows([]string{"index_position", "expression"})
+	for i, k := range keys {
+		ir.AddRow(i+1, k)
+	}
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
-func MockIndexScan(mock sqlmock.Sqlmock, predicate string) {
-	b := `
+var indexScanQuery = `
 	SELECT
 		mz_indexes.id,
 		mz_indexes.name AS index_name,
 		mz_objects.name AS obj_name,
 		mz_schemas.name AS obj_schema_name,
 		mz_databases.name AS obj_database_name,
+		mz_clusters.name AS cluster_name,
 		comments.comment AS comment
 	FROM mz_indexes
 	JOIN mz_objects
@@ -272,6 +405,8 @@ func MockIndexScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_objects.schema_id = mz_schemas.id
 	LEFT JOIN mz_databases
 		ON mz_schemas.database_id = mz_databases.id
+	LEFT JOIN mz_clusters
+		ON mz_indexes.cluster_id = mz_clusters.id
 	LEFT JOIN \(
 		SELECT id, comment
 		FROM mz_internal.mz_comments
@@ -279,9 +414,31 @@ func MockIndexScan(mock sqlmock.Sqlmock, predicate string) {
 	\) comments
 		ON mz_indexes.id = comments.id`
 
-	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"id", "index_name", "obj_name", "obj_schema_name", "obj_database_name"}).
-		AddRow("u1", "index", "obj", "schema", "database")
+func MockIndexScan(mock sqlmock.Sqlmock, predicate string) {
+	q := mockQueryBuilder(indexScanQuery, predicate, "")
+	ir := mock.NewRows([]string{"id", "index_name", "obj_name", "obj_schema_name", "obj_database_name", "cluster_name"}).
+		AddRow("u1", "index", "obj", "schema", "database", "cluster")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+// MockObjectIndexScan lists the indexes of an object, by id and name.
+func MockObjectIndexScan(mock sqlmock.Sqlmock, predicate string, indexes map[string]string) {
+	q := mockQueryBuilder(indexScanQuery, predicate, "")
+	ir := mock.NewRows([]string{"id", "index_name", "obj_name", "obj_schema_name", "obj_database_name", "cluster_name"})
+	for id, name := range indexes {
+		ir.AddRow(id, name, "source", "schema", "database", "cluster")
+	}
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+// MockObjectIndexClusterScan lists the indexes of an object in order, each
+// given as its id, name and cluster.
+func MockObjectIndexClusterScan(mock sqlmock.Sqlmock, predicate string, indexes ...[3]string) {
+	q := mockQueryBuilder(indexScanQuery, predicate, "")
+	ir := mock.NewRows([]string{"id", "index_name", "obj_name", "obj_schema_name", "obj_database_name", "cluster_name"})
+	for _, i := range indexes {
+		ir.AddRow(i[0], i[1], "source", "schema", "database", i[2])
+	}
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
@@ -327,6 +484,44 @@ func MockSystemPrivilege(mock sqlmock.Sqlmock) {
 	mock.ExpectQuery(b).WillReturnRows(ir)
 }
 
+func MockObjectSearchScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_objects.id,
+		mz_objects.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_objects.type,
+		mz_roles.name AS owner_name,
+		mz_clusters.name AS cluster_name,
+		comments.comment AS comment,
+		mz_object_history.created_at
+	FROM mz_objects
+	JOIN mz_schemas
+		ON mz_objects.schema_id = mz_schemas.id
+	LEFT JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_roles
+		ON mz_objects.owner_id = mz_roles.id
+	LEFT JOIN mz_clusters
+		ON mz_objects.cluster_id = mz_clusters.id
+	LEFT JOIN mz_internal.mz_object_history
+		ON mz_objects.id = mz_object_history.id
+	LEFT JOIN \(
+		SELECT id, object_type, comment
+		FROM mz_internal.mz_comments
+		WHERE object_sub_id IS NULL
+	\) comments
+		ON mz_objects.id = comments.id
+		AND mz_objects.type = comments.object_type`
+
+	q := mockQueryBuilder(b, predicate, "ORDER BY mz_databases.name, mz_schemas.name, mz_objects.name")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "type", "owner_name", "cluster_name", "comment", "created_at"}).
+		AddRow("u1", "orders", "schema", "database", "materialized-view", "owner", "cluster", "orders by customer", "2026-01-01T00:00:00Z").
+		AddRow("u2", "orders_view", "schema", "database", "view", "owner", nil, nil, "2026-01-02T00:00:00Z")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockRoleScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -362,6 +557,20 @@ func MockRoleGrantScan(mock sqlmock.Sqlmock) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockRoleMembershipScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT mz_roles.name AS role_name
+	FROM mz_role_members
+	JOIN mz_roles
+		ON mz_role_members.role_id = mz_roles.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"role_name"}).
+		AddRow("analyst").
+		AddRow("dev_role")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockSchemaScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -456,6 +665,32 @@ func MockSinkScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockSinkKafkaScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_sinks.id,
+		mz_sinks.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_kafka_sinks.topic
+	FROM mz_sinks
+	JOIN mz_schemas
+		ON mz_sinks.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_kafka_sinks
+		ON mz_sinks.id = mz_kafka_sinks.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "topic"}).
+		AddRow("u1", "sink", "schema", "database", "topic")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+
+	MockShowCreate(mock, `SINK "database"."schema"."sink"`,
+		`CREATE SINK "database"."schema"."sink" IN CLUSTER "cluster" FROM "database"."public"."item" INTO KAFKA CONNECTION "materialize"."public"."kafka_conn" (TOPIC = 'topic', COMPRESSION TYPE = gzip) KEY ("key_1", "key_2") NOT ENFORCED FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_conn" (AVRO KEY FULLNAME = 'avro_key_fullname', AVRO VALUE FULLNAME = 'avro_value_fullname', DOC ON TYPE "database"."public"."item" = 'top-level comment') ENVELOPE UPSERT`,
+	)
+}
+
 func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -495,7 +730,148 @@ func MockSourceScan(mock sqlmock.Sqlmock, predicate string) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockSourceKafkaScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_sources.id,
+		mz_sources.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_kafka_sources.topic
+	FROM mz_sources
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_kafka_sources
+		ON mz_sources.id = mz_kafka_sources.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "topic"}).
+		AddRow("u1", "source", "schema", "database", "topic")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+
+	MockShowCreate(mock, `SOURCE "database"."schema"."source"`,
+		`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM KAFKA CONNECTION "materialize"."public"."kafka_conn" (START OFFSET = (1, 2, 3), TOPIC = 'topic') FORMAT AVRO USING CONFLUENT SCHEMA REGISTRY CONNECTION "database"."schema"."csr_conn" SEED VALUE SCHEMA '{"type": "record", "name": "row", "fields": [{"name": "a", "type": "long"}]}' INCLUDE KEY AS "key", HEADERS AS "headers", PARTITION AS "partition", OFFSET AS "offset", TIMESTAMP AS "timestamp" ENVELOPE UPSERT EXPOSE PROGRESS AS "database"."schema"."source_progress"`,
+	)
+}
+
+func MockSourcePostgresScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_sources.id,
+		mz_sources.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_postgres_sources.replication_slot
+	FROM mz_sources
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_internal.mz_postgres_sources
+		ON mz_sources.id = mz_postgres_sources.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "replication_slot"}).
+		AddRow("u1", "source", "schema", "database", "materialize_u1")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+
+	MockShowCreate(mock, `SOURCE "database"."schema"."source"`,
+		`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM POSTGRES CONNECTION "materialize"."public"."pg_connection" (PUBLICATION = 'mz_source', DETAILS = 'abc123') FOR TABLES ("postgres"."public"."name1" AS "database"."schema"."alias", "postgres"."public"."name2" AS "database"."schema"."name2") EXPOSE PROGRESS AS "database"."schema"."source_progress"`,
+	)
+}
+
+func MockSourcePostgresTableScan(mock sqlmock.Sqlmock, predicate string) {
+	mockSourcePostgresTableScan(mock, predicate, "running", "")
+}
+
+// MockSourcePostgresTableSchemaChangeScan reports the subsource "name2" as
+// stalled by an upstream schema change
+func MockSourcePostgresTableSchemaChangeScan(mock sqlmock.Sqlmock, predicate string) {
+	mockSourcePostgresTableScan(mock, predicate, "stalled", "source must be dropped and recreated due to failure: incompatible schema change: source table name2 with oid 16385 has been altered")
+}
+
+func mockSourcePostgresTableScan(mock sqlmock.Sqlmock, predicate, status, err string) {
+	b := `
+	SELECT
+		mz_sources.id,
+		mz_sources.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_postgres_source_tables.schema_name AS upstream_schema_name,
+		mz_postgres_source_tables.table_name AS upstream_table_name,
+		mz_source_statuses.status,
+		mz_source_statuses.error
+	FROM mz_internal.mz_object_dependencies
+	JOIN mz_sources
+		ON mz_object_dependencies.referenced_object_id = mz_sources.id
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_internal.mz_postgres_source_tables
+		ON mz_sources.id = mz_postgres_source_tables.id
+	LEFT JOIN mz_internal.mz_source_statuses
+		ON mz_sources.id = mz_source_statuses.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "upstream_schema_name", "upstream_table_name", "status", "error"}).
+		AddRow("u2", "alias", "schema", "database", "public", "name1", "running", nil).
+		AddRow("u3", "name2", "schema", "database", "public", "name2", status, err)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockSourceWebhookScan(mock sqlmock.Sqlmock, predicate string) {
+	MockSourceScan(mock, predicate)
+
+	MockShowCreate(mock, `SOURCE "database"."schema"."source"`,
+		`CREATE SOURCE "database"."schema"."source" IN CLUSTER "cluster" FROM WEBHOOK BODY FORMAT JSON INCLUDE HEADERS CHECK (WITH (BODY AS "bytes", HEADERS AS "headers") check_expression)`,
+	)
+
+	MockSourceWebhookUrlScan(mock)
+}
+
+func MockSourceWebhookUrlScan(mock sqlmock.Sqlmock) {
+	b := `
+	SELECT mz_webhook_sources.url
+	FROM mz_internal.mz_webhook_sources`
+
+	q := mockQueryBuilder(b, "WHERE mz_webhook_sources.id = 'u1'", "")
+	ir := mock.NewRows([]string{"url"}).
+		AddRow("https://abc123.us-east-1.aws.materialize.cloud/api/webhook/database/schema/source")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockSourceColumnScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_columns.id,
+		mz_columns.name,
+		mz_columns.position,
+		mz_columns.nullable,
+		mz_columns.type
+	FROM mz_columns
+	JOIN mz_sources
+		ON mz_columns.id = mz_sources.id
+	JOIN mz_schemas
+		ON mz_sources.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id`
+
+	q := mockQueryBuilder(b, predicate, "ORDER BY mz_columns.id, mz_columns.position")
+	ir := mock.NewRows([]string{"id", "name", "position", "nullable", "type"}).
+		AddRow("u1", "key", "1", false, "text").
+		AddRow("u1", "error", "2", true, "record")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockSubsourceScan(mock sqlmock.Sqlmock, predicate string) {
+	MockSubsourceNamesScan(mock, predicate, "object")
+}
+
+// MockSubsourceNamesScan returns a subsource for each of the names.
+func MockSubsourceNamesScan(mock sqlmock.Sqlmock, predicate string, names ...string) {
 	b := `
 	SELECT
 		mz_object_dependencies.object_id,
@@ -513,8 +889,10 @@ func MockSubsourceScan(mock sqlmock.Sqlmock, predicate string) {
 		ON mz_schemas.database_id = mz_databases.id`
 
 	q := mockQueryBuilder(b, predicate, "")
-	ir := mock.NewRows([]string{"object_id", "referenced_object_id", "object_name", "schema_name", "database_name", "type"}).
-		AddRow("u1", "u2", "object", "schema", "database", "source")
+	ir := mock.NewRows([]string{"object_id", "referenced_object_id", "object_name", "schema_name", "database_name", "type"})
+	for _, n := range names {
+		ir.AddRow("u1", "u2", n, "schema", "database", "source")
+	}
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
@@ -550,6 +928,45 @@ func MockSystemGrantScan(mock sqlmock.Sqlmock) {
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
+func MockSourceTableScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_tables.id,
+		mz_tables.name,
+		mz_schemas.name AS schema_name,
+		mz_databases.name AS database_name,
+		mz_sources.name AS source_name,
+		source_schemas.name AS source_schema_name,
+		source_databases.name AS source_database_name,
+		comments.comment AS comment,
+		mz_roles.name AS owner_name
+	FROM mz_tables
+	JOIN mz_schemas
+		ON mz_tables.schema_id = mz_schemas.id
+	JOIN mz_databases
+		ON mz_schemas.database_id = mz_databases.id
+	JOIN mz_sources
+		ON mz_tables.source_id = mz_sources.id
+	JOIN mz_schemas source_schemas
+		ON mz_sources.schema_id = source_schemas.id
+	JOIN mz_databases source_databases
+		ON source_schemas.database_id = source_databases.id
+	JOIN mz_roles
+		ON mz_tables.owner_id = mz_roles.id
+	LEFT JOIN \(
+		SELECT id, comment
+		FROM mz_internal.mz_comments
+		WHERE object_type = 'table'
+		AND object_sub_id IS NULL
+	\) comments
+		ON mz_tables.id = comments.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "source_name", "source_schema_name", "source_database_name", "comment", "owner_name"}).
+		AddRow("u1", "table", "schema", "database", "source", "schema", "database", "comment", "materialize")
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
 func MockTableScan(mock sqlmock.Sqlmock, predicate string) {
 	b := `
 	SELECT
@@ -582,6 +999,10 @@ func MockTableScan(mock sqlmock.Sqlmock, predicate string) {
 }
 
 func MockTypeScan(mock sqlmock.Sqlmock, predicate string) {
+	MockTypeCategoryScan(mock, predicate, "list")
+}
+
+func MockTypeCategoryScan(mock sqlmock.Sqlmock, predicate, category string) {
 	b := `
 	SELECT
 		mz_types.id,
@@ -608,7 +1029,58 @@ func MockTypeScan(mock sqlmock.Sqlmock, predicate string) {
 
 	q := mockQueryBuilder(b, predicate, "")
 	ir := mock.NewRows([]string{"id", "name", "schema_name", "database_name", "category", "owner_name", "privileges"}).
-		AddRow("u1", "type", "schema", "database", "category", "joe", defaultPrivilege)
+		AddRow("u1", "type", "schema", "database", category, "joe", defaultPrivilege)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockListTypeScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_list_types.id,
+		element_types.name AS element_name,
+		element_schemas.name AS element_schema_name,
+		element_databases.name AS element_database_name
+	FROM mz_list_types
+	JOIN mz_types element_types
+		ON mz_list_types.element_id = element_types.id
+	JOIN mz_schemas element_schemas
+		ON element_types.schema_id = element_schemas.id
+	LEFT JOIN mz_databases element_databases
+		ON element_schemas.database_id = element_databases.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "element_name", "element_schema_name", "element_database_name"}).
+		AddRow("u1", "int4", "pg_catalog", nil)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockMapTypeScan(mock sqlmock.Sqlmock, predicate string) {
+	b := `
+	SELECT
+		mz_map_types.id,
+		key_types.name AS key_name,
+		key_schemas.name AS key_schema_name,
+		key_databases.name AS key_database_name,
+		value_types.name AS value_name,
+		value_schemas.name AS value_schema_name,
+		value_databases.name AS value_database_name
+	FROM mz_map_types
+	JOIN mz_types key_types
+		ON mz_map_types.key_id = key_types.id
+	JOIN mz_schemas key_schemas
+		ON key_types.schema_id = key_schemas.id
+	LEFT JOIN mz_databases key_databases
+		ON key_schemas.database_id = key_databases.id
+	JOIN mz_types value_types
+		ON mz_map_types.value_id = value_types.id
+	JOIN mz_schemas value_schemas
+		ON value_types.schema_id = value_schemas.id
+	LEFT JOIN mz_databases value_databases
+		ON value_schemas.database_id = value_databases.id`
+
+	q := mockQueryBuilder(b, predicate, "")
+	ir := mock.NewRows([]string{"id", "key_name", "key_schema_name", "key_database_name", "value_name", "value_schema_name", "value_database_name"}).
+		AddRow("u1", "text", "pg_catalog", nil, "nested", "schema", "database")
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
 
@@ -643,3 +1115,16 @@ func MockViewScan(mock sqlmock.Sqlmock, predicate string) {
 		AddRow("u1", "view", "schema", "database", "joe", defaultPrivilege)
 	mock.ExpectQuery(q).WillReturnRows(ir)
 }
+
+func MockSystemParameterScan(mock sqlmock.Sqlmock, name, value string) {
+	q := fmt.Sprintf(`SHOW "%s";`, name)
+	ir := mock.NewRows([]string{name}).AddRow(value)
+	mock.ExpectQuery(q).WillReturnRows(ir)
+}
+
+func MockSystemParametersScan(mock sqlmock.Sqlmock) {
+	ir := mock.NewRows([]string{"name", "setting", "description"}).
+		AddRow("max_clusters", "100", "The maximum number of clusters in the region").
+		AddRow("max_sources", "200", "The maximum number of sources in the region")
+	mock.ExpectQuery(`SHOW ALL;`).WillReturnRows(ir)
+}
@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,8 +18,12 @@ import (
 type ProviderMeta struct {
 	// DB is a map that associates each supported region with its corresponding
 	// database client. This allows for region-specific database operations.
+	// Clients are opened on the first use of a region by GetDBClientFromMeta.
 	DB map[clients.Region]*clients.DBClient
 
+	// DBConfig holds the settings used to open the database client of a region.
+	DBConfig DBConfig
+
 	// Frontegg represents the client used to interact with the Frontegg API,
 	// which may involve authentication, token management, etc.
 	Frontegg *clients.FronteggClient
@@ -32,54 +38,199 @@ type ProviderMeta struct {
 	// RegionsEnabled is a map indicating which regions are currently enabled
 	// for use. This can be used to quickly check the availability in different regions.
 	RegionsEnabled map[clients.Region]bool
+
+	// dbMu guards DB and RegionsEnabled. It is not held while a database
+	// client is opened.
+	dbMu sync.Mutex
+}
+
+// DBConfig holds the connection settings shared by the database clients of
+// all regions.
+type DBConfig struct {
+	Database        string
+	SslMode         string
+	ApplicationName string
+	Version         string
+	Pool            clients.DBPoolConfig
+}
+
+// RegionNotEnabledError is returned when a region has no resolvable SQL
+// address.
+type RegionNotEnabledError struct {
+	Region clients.Region
+}
+
+func (e *RegionNotEnabledError) Error() string {
+	return fmt.Sprintf("region '%s' is not enabled", e.Region)
 }
 
 var DefaultRegion string
 
+// RegionMeta pins the database client returned by GetDBClientFromMeta to a
+// region, to run the operations of a resource deployed to several regions
+// once per region.
+type RegionMeta struct {
+	*ProviderMeta
+	Region clients.Region
+}
+
+// GetProviderMeta returns the provider meta. The Frontegg token is refreshed
+// by the transport of the Frontegg HTTP client when needed.
 func GetProviderMeta(meta interface{}) (*ProviderMeta, error) {
-	providerMeta := meta.(*ProviderMeta)
+	var providerMeta *ProviderMeta
+	switch m := meta.(type) {
+	case *ProviderMeta:
+		providerMeta = m
+	case *RegionMeta:
+		providerMeta = m.ProviderMeta
+	}
 
-	if err := providerMeta.Frontegg.NeedsTokenRefresh(); err != nil {
-		err := providerMeta.Frontegg.RefreshToken()
-		if err != nil {
-			return nil, fmt.Errorf("failed to refresh token: %v", err)
-		}
+	if providerMeta == nil {
+		return nil, fmt.Errorf("provider is not configured")
 	}
 
 	return providerMeta, nil
 }
 
 func GetDBClientFromMeta(meta interface{}, d *schema.ResourceData) (*sqlx.DB, clients.Region, error) {
+	var region clients.Region
+	if d != nil {
+		if r, ok := d.Get("region").(string); ok {
+			region = clients.Region(r)
+		}
+	}
+
+	return GetDBClientForRegion(meta, region)
+}
+
+// GetDBClientForRegion returns the database client of a region. When region
+// is empty, the default region is used.
+func GetDBClientForRegion(meta interface{}, region clients.Region) (*sqlx.DB, clients.Region, error) {
 	providerMeta, err := GetProviderMeta(meta)
 	if err != nil {
 		return nil, "", err
 	}
 
 	// Determine the region to use, if one is not specified, use the default region
-	var region clients.Region
-	if d != nil && d.Get("region") != "" {
-		region = clients.Region(d.Get("region").(string))
-	} else {
+	if regionMeta, ok := meta.(*RegionMeta); ok {
+		region = regionMeta.Region
+	} else if region == "" {
 		region = providerMeta.DefaultRegion
 	}
 
-	// Check if the region is enabled using the stored information
-	enabled, exists := providerMeta.RegionsEnabled[region]
-	if !exists {
-		return nil, region, fmt.Errorf("no information available for region: %s", region)
+	dbClient, err := providerMeta.dbClient(context.Background(), region)
+	if err != nil {
+		return nil, region, err
 	}
 
-	if !enabled {
-		return nil, region, fmt.Errorf("region '%s' is not enabled", region)
+	return dbClient.SQLX(), region, nil
+}
+
+// dbClient returns the database client of a region. The client is opened on
+// first use with the SQL address of the region from the Cloud API. The lock
+// is only held to read and store the cached client, so a region being opened
+// does not block the operations of other regions.
+func (p *ProviderMeta) dbClient(ctx context.Context, region clients.Region) (*clients.DBClient, error) {
+	p.dbMu.Lock()
+	enabled, exists := p.RegionsEnabled[region]
+	stale, cached := p.DB[region]
+	p.dbMu.Unlock()
+
+	if cached && (!exists || enabled) {
+		return stale, nil
 	}
 
-	// Retrieve the appropriate DBClient for the region from the map
-	dbClient, exists := providerMeta.DB[region]
-	if !exists {
-		return nil, region, fmt.Errorf("no database client for region: %s", region)
+	// Without a Cloud API client only the stored information is available
+	if p.CloudAPI == nil {
+		if !exists {
+			return nil, fmt.Errorf("no information available for region: %s", region)
+		}
+		if !enabled {
+			return nil, &RegionNotEnabledError{Region: region}
+		}
+		return nil, fmt.Errorf("no database client for region: %s", region)
 	}
 
-	return dbClient.SQLX(), region, nil
+	provider, err := p.CloudAPI.GetProvider(ctx, string(region))
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := p.CloudAPI.GetRegionDetails(ctx, *provider)
+	if err != nil {
+		return nil, fmt.Errorf("error getting details for region %s: %v", region, err)
+	}
+
+	if details.RegionInfo == nil || !details.RegionInfo.Resolvable {
+		p.dbMu.Lock()
+		defer p.dbMu.Unlock()
+		p.setRegionEnabled(region, false)
+		return nil, &RegionNotEnabledError{Region: region}
+	}
+
+	// Get the database connection details for the region
+	host, port, err := clients.SplitHostPort(details.RegionInfo.SqlAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error splitting host and port for region %s: %v", region, err)
+	}
+
+	dbClient, diags := clients.NewDBClient(
+		host,
+		p.Frontegg.GetEmail(),
+		p.Frontegg.GetPassword(),
+		port,
+		p.DBConfig.Database,
+		p.DBConfig.ApplicationName,
+		p.DBConfig.Version,
+		p.DBConfig.SslMode,
+		p.DBConfig.Pool,
+	)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s for region %s: %s", diags[0].Summary, region, diags[0].Detail)
+	}
+
+	p.dbMu.Lock()
+	defer p.dbMu.Unlock()
+
+	p.setRegionEnabled(region, true)
+	if p.DB == nil {
+		p.DB = make(map[clients.Region]*clients.DBClient)
+	}
+	if current, ok := p.DB[region]; ok {
+		if current != stale {
+			// Another operation opened the client of the region meanwhile
+			dbClient.Close()
+			return current, nil
+		}
+		// The region was disabled and enabled again
+		current.Close()
+	}
+	p.DB[region] = dbClient
+
+	return dbClient, nil
+}
+
+// setRegionEnabled records whether a region is enabled. The caller must hold
+// p.dbMu.
+func (p *ProviderMeta) setRegionEnabled(region clients.Region, enabled bool) {
+	if p.RegionsEnabled == nil {
+		p.RegionsEnabled = make(map[clients.Region]bool)
+	}
+	p.RegionsEnabled[region] = enabled
+}
+
+// MarkRegionDisabled records that a region was disabled and closes its
+// database client, so the region is looked up again on its next use.
+func (p *ProviderMeta) MarkRegionDisabled(region clients.Region) {
+	p.dbMu.Lock()
+	defer p.dbMu.Unlock()
+
+	p.setRegionEnabled(region, false)
+
+	if dbClient, ok := p.DB[region]; ok {
+		dbClient.Close()
+		delete(p.DB, region)
+	}
 }
 
 func SetDefaultRegion(region string) error {
@@ -1,12 +1,17 @@
 package utils
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/clients"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	_ "github.com/jackc/pgx/stdlib"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -58,6 +63,147 @@ func TestGetDBClientFromMeta(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// withCloudServer serves a single region, aws/us-east-1, that is enabled
+// depending on the enabled argument.
+func withCloudServer(t *testing.T, enabled bool, f func(*ProviderMeta)) {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/cloud-regions":
+			json.NewEncoder(w).Encode(clients.CloudProviderResponse{
+				Data: []clients.CloudProvider{{ID: "aws/us-east-1", Name: "us-east-1", Url: server.URL, CloudProvider: "aws"}},
+			})
+		case "/api/region":
+			if !enabled {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			json.NewEncoder(w).Encode(clients.CloudRegion{
+				RegionInfo: &clients.RegionInfo{SqlAddress: "sql.materialize.com:6875", Resolvable: true},
+			})
+		default:
+			http.Error(w, "Not Found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	fronteggClient := &clients.FronteggClient{
+		HTTPClient:  &http.Client{},
+		Email:       "user@example.com",
+		Password:    "password",
+		TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	f(&ProviderMeta{
+		Frontegg:      fronteggClient,
+		CloudAPI:      clients.NewCloudAPIClient(fronteggClient, server.URL),
+		DefaultRegion: clients.AwsUsEast1,
+		DBConfig: DBConfig{
+			Database: "materialize",
+			SslMode:  "require",
+			Pool:     clients.DBPoolConfig{MaxOpenConns: 2},
+		},
+	})
+}
+
+func TestGetDBClientFromMetaLazy(t *testing.T) {
+	withCloudServer(t, true, func(providerMeta *ProviderMeta) {
+		// No client is opened until the region is used
+		require.Empty(t, providerMeta.DB)
+
+		db, region, err := GetDBClientFromMeta(providerMeta, nil)
+		require.NoError(t, err)
+		require.Equal(t, clients.AwsUsEast1, region)
+		require.Equal(t, 2, db.Stats().MaxOpenConnections)
+		require.True(t, providerMeta.RegionsEnabled[clients.AwsUsEast1])
+
+		// The client is cached
+		cached, _, err := GetDBClientFromMeta(providerMeta, nil)
+		require.NoError(t, err)
+		require.Same(t, db, cached)
+	})
+}
+
+func TestGetDBClientFromMetaRegionNotEnabled(t *testing.T) {
+	withCloudServer(t, false, func(providerMeta *ProviderMeta) {
+		_, _, err := GetDBClientFromMeta(providerMeta, nil)
+		require.Error(t, err)
+
+		var notEnabled *RegionNotEnabledError
+		require.True(t, errors.As(err, &notEnabled))
+		require.Equal(t, "region 'aws/us-east-1' is not enabled", err.Error())
+		require.Empty(t, providerMeta.DB)
+	})
+}
+
+func TestGetDBClientFromMetaConcurrentRegions(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/cloud-regions":
+			json.NewEncoder(w).Encode(clients.CloudProviderResponse{
+				Data: []clients.CloudProvider{{ID: "aws/us-west-2", Name: "us-west-2", Url: server.URL + "/west", CloudProvider: "aws"}},
+			})
+		case "/west/api/region":
+			// The region is slow to answer
+			close(started)
+			<-release
+			json.NewEncoder(w).Encode(clients.CloudRegion{
+				RegionInfo: &clients.RegionInfo{SqlAddress: "sql.materialize.com:6875", Resolvable: true},
+			})
+		default:
+			http.Error(w, "Not Found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	fronteggClient := &clients.FronteggClient{
+		HTTPClient:  &http.Client{},
+		TokenExpiry: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	providerMeta := &ProviderMeta{
+		DB:             map[clients.Region]*clients.DBClient{clients.AwsUsEast1: {DB: sqlx.NewDb(db, "sqlmock")}},
+		RegionsEnabled: map[clients.Region]bool{clients.AwsUsEast1: true},
+		Frontegg:       fronteggClient,
+		CloudAPI:       clients.NewCloudAPIClient(fronteggClient, server.URL),
+		DBConfig:       DBConfig{Database: "materialize", SslMode: "require"},
+	}
+
+	opened := make(chan error)
+	go func() {
+		_, _, err := GetDBClientForRegion(providerMeta, clients.AwsUsWest2)
+		opened <- err
+	}()
+	<-started
+
+	// The cached client of another region is returned while the region is
+	// being opened
+	cached := make(chan error)
+	go func() {
+		_, _, err := GetDBClientForRegion(providerMeta, clients.AwsUsEast1)
+		cached <- err
+	}()
+	select {
+	case err := <-cached:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		close(release)
+		t.Fatal("cached client blocked by the region being opened")
+	}
+
+	close(release)
+	require.NoError(t, <-opened)
+	require.True(t, providerMeta.RegionsEnabled[clients.AwsUsWest2])
+}
+
 func TestTransformIdWithRegion(t *testing.T) {
 	testCases := []map[string]interface{}{
 		{